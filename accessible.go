@@ -0,0 +1,144 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+)
+
+type AccessibleOption struct {
+	// Add title, desc and aria attributes to svg, and group the shapes of each series.
+	// It is only supported by svg output
+	Enabled bool
+	// The title of svg, default is the text of chart title
+	Title string
+	// The description of svg, default is the subtext of chart title
+	// or the summary of series
+	Description string
+	// Add a hidden data table of series to svg
+	DataTable bool
+}
+
+// getAccessibleName returns the name of series, which is "Series n" if it is empty
+func getAccessibleName(series Series) string {
+	if series.Name != "" {
+		return series.Name
+	}
+	return "Series " + strconv.Itoa(series.index+1)
+}
+
+type accessibleContent struct {
+	title       string
+	description string
+	xAxisData   []string
+	seriesList  SeriesList
+}
+
+// setAccessible sets the title, description and data table of svg
+func (p *Painter) setAccessible(opt AccessibleOption, content accessibleContent) {
+	r, ok := p.render.(*svgRenderer)
+	if !ok || !opt.Enabled {
+		return
+	}
+	r.accessible = true
+	title := opt.Title
+	if title == "" {
+		title = content.title
+	}
+	if title == "" {
+		title = "Chart"
+	}
+	desc := opt.Description
+	if desc == "" {
+		desc = content.description
+	}
+	if desc == "" && len(content.seriesList) != 0 {
+		names := make([]string, len(content.seriesList))
+		for index, series := range content.seriesList {
+			names[index] = getAccessibleName(series)
+		}
+		desc = fmt.Sprintf("%d series: %s", len(names), strings.Join(names, ", "))
+	}
+	r.title = title
+	r.description = desc
+	r.attributes = append(r.attributes, svgAttribute{
+		Name:  "role",
+		Value: "img",
+	}, svgAttribute{
+		Name:  "aria-label",
+		Value: title,
+	})
+	if opt.DataTable && len(content.seriesList) != 0 {
+		r.appendix = p.accessibleDataTable(title, content)
+	}
+}
+
+// formatValue formats the value of data by value formatter
+func (p *Painter) formatValue(value float64) string {
+	if p.valueFormatter != nil {
+		return p.valueFormatter(value)
+	}
+	return humanize.CommafWithDigits(value, 2)
+}
+
+// accessibleDataTable returns the hidden html table of series data
+func (p *Painter) accessibleDataTable(title string, content accessibleContent) string {
+	maxCount := 0
+	for _, series := range content.seriesList {
+		if len(series.Data) > maxCount {
+			maxCount = len(series.Data)
+		}
+	}
+	buf := strings.Builder{}
+	// 不可见但保留在无障碍树中
+	buf.WriteString(`<foreignObject x="0" y="0" width="1" height="1" style="overflow:hidden;opacity:0">`)
+	buf.WriteString(`<table xmlns="http://www.w3.org/1999/xhtml">`)
+	buf.WriteString("<caption>" + html.EscapeString(title) + "</caption>")
+	buf.WriteString(`<thead><tr><th scope="col">Series</th>`)
+	for i := 0; i < maxCount; i++ {
+		name := strconv.Itoa(i + 1)
+		if i < len(content.xAxisData) {
+			name = content.xAxisData[i]
+		}
+		buf.WriteString(`<th scope="col">` + html.EscapeString(name) + "</th>")
+	}
+	buf.WriteString("</tr></thead><tbody>")
+	for _, series := range content.seriesList {
+		buf.WriteString(`<tr><th scope="row">` + html.EscapeString(getAccessibleName(series)) + "</th>")
+		for i := 0; i < maxCount; i++ {
+			value := "-"
+			if i < len(series.Data) && series.Data[i].Value != nullValue {
+				value = p.formatValue(series.Data[i].Value)
+			}
+			buf.WriteString("<td>" + html.EscapeString(value) + "</td>")
+		}
+		buf.WriteString("</tr>")
+	}
+	buf.WriteString("</tbody></table></foreignObject>")
+	return buf.String()
+}
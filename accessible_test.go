@@ -0,0 +1,124 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func TestPainterAccessible(t *testing.T) {
+	assert := assert.New(t)
+
+	newPainter := func() *Painter {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  100,
+			Height: 50,
+		})
+		assert.Nil(err)
+		return p
+	}
+	draw := func(p *Painter) string {
+		p.startSeriesGroup(Series{
+			Name: "A&B",
+		})
+		p.SetDrawingStyle(Style{
+			FillColor: drawing.ColorBlack,
+		})
+		p.Rect(Box{
+			Right:  10,
+			Bottom: 10,
+		})
+		p.endGroup()
+		buf, err := p.Bytes()
+		assert.Nil(err)
+		return string(buf)
+	}
+
+	// 未启用时不输出分组
+	assert.Equal(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="100" height="50">\n<path  d="M 0 0
+L 10 0
+L 10 10
+L 0 10
+L 0 0" style="stroke-width:0;stroke:none;fill:rgba(0,0,0,1.0)"/></svg>`, draw(newPainter()))
+
+	p := newPainter()
+	p.setAccessible(AccessibleOption{
+		Enabled: true,
+	}, accessibleContent{
+		title:       "Sales",
+		description: "Daily sales",
+	})
+	assert.Equal(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="100" height="50" role="img" aria-label="Sales">\n<title>Sales</title><desc>Daily sales</desc><g role="group" aria-label="A&amp;B"><path  d="M 0 0
+L 10 0
+L 10 10
+L 0 10
+L 0 0" style="stroke-width:0;stroke:none;fill:rgba(0,0,0,1.0)"/></g></svg>`, draw(p))
+}
+
+func TestChartAccessible(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := BarRender([][]float64{
+		{120, 200},
+		{80, nullValue},
+	},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Traffic"),
+		XAxisDataOptionFunc([]string{"Mon", "Tue"}),
+		LegendLabelsOptionFunc([]string{"Email", ""}),
+		func(opt *ChartOption) {
+			opt.Accessible = AccessibleOption{
+				Enabled:   true,
+				DataTable: true,
+			}
+		},
+	)
+	assert.Nil(err)
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	data := string(buf)
+	assert.True(strings.HasPrefix(data, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="600" height="400" role="img" aria-label="Traffic">\n<title>Traffic</title><desc>2 series: Email, Series 2</desc>`))
+	assert.Equal(1, strings.Count(data, `<g role="group" aria-label="Email">`))
+	assert.Equal(1, strings.Count(data, `<g role="group" aria-label="Series 2">`))
+	assert.Equal(2, strings.Count(data, "</g>"))
+	assert.True(strings.HasSuffix(data, `<table xmlns="http://www.w3.org/1999/xhtml"><caption>Traffic</caption><thead><tr><th scope="col">Series</th><th scope="col">Mon</th><th scope="col">Tue</th></tr></thead><tbody><tr><th scope="row">Email</th><td>120</td><td>200</td></tr><tr><th scope="row">Series 2</th><td>80</td><td>-</td></tr></tbody></table></foreignObject></svg>`))
+
+	// png忽略无障碍设置
+	p, err = BarRender([][]float64{
+		{120, 200},
+	},
+		PNGTypeOption(),
+		XAxisDataOptionFunc([]string{"Mon", "Tue"}),
+		func(opt *ChartOption) {
+			opt.Accessible.Enabled = true
+		},
+	)
+	assert.Nil(err)
+	_, err = p.Bytes()
+	assert.Nil(err)
+}
@@ -46,11 +46,26 @@ const (
 	ChartTypeFunnel = "funnel"
 	// horizontal bar
 	ChartTypeHorizontalBar = "horizontalBar"
+	// bar of polar coordinate
+	ChartTypePolarBar = "polarBar"
+	// line of polar coordinate
+	ChartTypePolarLine = "polarLine"
+	ChartTypeSunburst  = "sunburst"
+	// parallel coordinates
+	ChartTypeParallel = "parallel"
 )
 
 const (
 	ChartOutputSVG = "svg"
 	ChartOutputPNG = "png"
+	ChartOutputPDF = "pdf"
+	// jpeg and gif are encoded from the image of png renderer
+	ChartOutputJPEG = "jpeg"
+	ChartOutputGIF  = "gif"
+	// html wraps the svg with tooltips and legend toggling
+	ChartOutputHTML = "html"
+	// terminal renders the chart as unicode characters with ansi colors
+	ChartOutputTerminal = "terminal"
 )
 
 const (
@@ -65,6 +80,14 @@ const (
 	AlignLeft   = "left"
 	AlignRight  = "right"
 	AlignCenter = "center"
+	// AlignDecimal aligns the decimal point of numbers, it is only supported by table
+	AlignDecimal = "decimal"
+)
+
+const (
+	VerticalAlignTop    = "top"
+	VerticalAlignMiddle = "middle"
+	VerticalAlignBottom = "bottom"
 )
 
 const (
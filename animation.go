@@ -0,0 +1,260 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+const defaultAnimationDelay = 50
+
+type AnimationOption struct {
+	// The frames of animation
+	Frames []ChartOption
+	// The delay of each frame in 100ths of a second, default is 50
+	Delay int
+	// The delay of last frame in 100ths of a second, default is the same as delay
+	LastDelay int
+	// The loop count of animation, 0 means loop forever and -1 means show once
+	LoopCount int
+}
+
+// NewWindowFrames returns the frames of time-windowed dataset,
+// each frame shows the x axis data and series data of the window,
+// the window moves step by step.
+func NewWindowFrames(opt ChartOption, windowSize, step int) []ChartOption {
+	count := len(opt.XAxis.Data)
+	for _, series := range opt.SeriesList {
+		if len(series.Data) > count {
+			count = len(series.Data)
+		}
+	}
+	if windowSize <= 0 || windowSize > count {
+		windowSize = count
+	}
+	if step <= 0 {
+		step = 1
+	}
+	frames := make([]ChartOption, 0)
+	for start := 0; start+windowSize <= count; start += step {
+		end := start + windowSize
+		frame := opt
+		frame.XAxis.Data = sliceStrings(opt.XAxis.Data, start, end)
+		// 复制series，避免渲染时排序影响其它帧
+		frame.SeriesList = make(SeriesList, len(opt.SeriesList))
+		for index, series := range opt.SeriesList {
+			if start < len(series.Data) {
+				series.Data = series.Data[start:chart.MinInt(end, len(series.Data))]
+			} else {
+				series.Data = nil
+			}
+			frame.SeriesList[index] = series
+		}
+		frames = append(frames, frame)
+	}
+	return frames
+}
+
+func sliceStrings(data []string, start, end int) []string {
+	if start >= len(data) {
+		return nil
+	}
+	if end > len(data) {
+		end = len(data)
+	}
+	return data[start:end]
+}
+
+// newThemePalette returns the palette of theme, it contains the colors of theme
+// and the gradient colors between background and them for anti-aliasing
+func newThemePalette(theme ColorPalette, background Color, seriesCount int) color.Palette {
+	colors := []Color{
+		theme.GetTextColor(),
+		theme.GetAxisStrokeColor(),
+		theme.GetAxisSplitLineColor(),
+		defaultLightFontColor,
+		defaultDarkFontColor,
+		drawing.ColorWhite,
+	}
+	for i := 0; i < seriesCount; i++ {
+		colors = append(colors, theme.GetSeriesColor(i))
+	}
+	background = background.WithAlpha(255)
+	exists := map[Color]bool{
+		background: true,
+	}
+	baseColors := make([]Color, 0, len(colors))
+	for _, c := range colors {
+		c = c.WithAlpha(255)
+		if exists[c] {
+			continue
+		}
+		exists[c] = true
+		baseColors = append(baseColors, c)
+	}
+	// 最多256种颜色
+	if len(baseColors) > 255 {
+		baseColors = baseColors[:255]
+	}
+	steps := 1
+	if len(baseColors) != 0 {
+		steps = (256 - 1) / len(baseColors)
+	}
+	palette := color.Palette{
+		background,
+	}
+	for _, c := range baseColors {
+		for i := 1; i <= steps; i++ {
+			palette = append(palette, blendColor(background, c, float64(i)/float64(steps)))
+		}
+	}
+	return palette
+}
+
+// blendColor returns the color between from and to
+func blendColor(from, to Color, percent float64) Color {
+	blend := func(a, b uint8) uint8 {
+		return uint8(float64(a) + (float64(b)-float64(a))*percent + 0.5)
+	}
+	return Color{
+		R: blend(from.R, to.R),
+		G: blend(from.G, to.G),
+		B: blend(from.B, to.B),
+		A: 255,
+	}
+}
+
+// paletteConverter converts the image to paletted image,
+// the index of color is cached for all frames
+type paletteConverter struct {
+	palette    color.Palette
+	background Color
+	cache      map[color.RGBA]uint8
+}
+
+func (pc *paletteConverter) convert(img image.Image) *image.Paletted {
+	// gif不支持半透明，以背景色合成
+	img = flattenImage(img, pc.background)
+	bounds := img.Bounds()
+	paletted := image.NewPaletted(bounds, pc.palette)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+			index, ok := pc.cache[c]
+			if !ok {
+				index = uint8(pc.palette.Index(c))
+				pc.cache[c] = index
+			}
+			paletted.SetColorIndex(x, y, index)
+		}
+	}
+	return paletted
+}
+
+// RenderAnimatedGIF renders the frames of chart option as animated gif,
+// all frames share the palette quantised from the theme colors
+func RenderAnimatedGIF(opt AnimationOption, opts ...OptionFunc) ([]byte, error) {
+	if len(opt.Frames) == 0 {
+		return nil, errors.New("frames of animation can not be empty")
+	}
+	delay := opt.Delay
+	if delay <= 0 {
+		delay = defaultAnimationDelay
+	}
+	lastDelay := opt.LastDelay
+	if lastDelay <= 0 {
+		lastDelay = delay
+	}
+	// 按所有帧中最多的series数量生成调色板
+	first := opt.Frames[0]
+	for _, fn := range opts {
+		fn(&first)
+	}
+	seriesCount := 0
+	for _, frame := range opt.Frames {
+		if len(frame.SeriesList) > seriesCount {
+			seriesCount = len(frame.SeriesList)
+		}
+	}
+	theme := NewTheme(first.Theme)
+	background := first.BackgroundColor
+	// 透明背景以假定的背景色合成
+	if first.TransparentBackground && !first.BackdropColor.IsZero() {
+		background = first.BackdropColor
+	}
+	if background.IsZero() {
+		background = theme.GetBackgroundColor()
+	}
+	converter := &paletteConverter{
+		palette:    newThemePalette(theme, background, seriesCount),
+		background: background,
+		cache:      make(map[color.RGBA]uint8),
+	}
+
+	result := &gif.GIF{
+		LoopCount: opt.LoopCount,
+	}
+	// 以png的形式渲染每一帧
+	frameOpts := make([]OptionFunc, 0, len(opts)+1)
+	frameOpts = append(frameOpts, opts...)
+	frameOpts = append(frameOpts, PNGTypeOption())
+	var bounds image.Rectangle
+	for index, frame := range opt.Frames {
+		p, err := Render(frame, frameOpts...)
+		if err != nil {
+			return nil, err
+		}
+		img, err := p.Image()
+		if err != nil {
+			p.Release()
+			return nil, err
+		}
+		if index == 0 {
+			bounds = img.Bounds()
+		} else if img.Bounds() != bounds {
+			p.Release()
+			return nil, errors.New("size of frames should be the same")
+		}
+		// 转换后的图片不再引用painter的像素
+		result.Image = append(result.Image, converter.convert(img))
+		p.Release()
+		d := delay
+		if index == len(opt.Frames)-1 {
+			d = lastDelay
+		}
+		result.Delay = append(result.Delay, d)
+	}
+	buf := bytes.Buffer{}
+	err := gif.EncodeAll(&buf, result)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
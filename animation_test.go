@@ -0,0 +1,122 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"bytes"
+	"image/gif"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func TestNewWindowFrames(t *testing.T) {
+	assert := assert.New(t)
+
+	opt := ChartOption{
+		XAxis: NewXAxisOption([]string{
+			"01",
+			"02",
+			"03",
+			"04",
+			"05",
+		}),
+		SeriesList: NewSeriesListDataFromValues([][]float64{
+			{1, 2, 3, 4, 5},
+			{10, 20, 30},
+		}),
+	}
+	frames := NewWindowFrames(opt, 3, 2)
+	assert.Equal(2, len(frames))
+	assert.Equal([]string{"01", "02", "03"}, frames[0].XAxis.Data)
+	assert.Equal([]string{"03", "04", "05"}, frames[1].XAxis.Data)
+	assert.Equal(NewSeriesDataFromValues([]float64{3, 4, 5}), frames[1].SeriesList[0].Data)
+	assert.Equal(NewSeriesDataFromValues([]float64{30}), frames[1].SeriesList[1].Data)
+	// 原数据不受影响
+	assert.Equal(5, len(opt.SeriesList[0].Data))
+
+	// 窗口大于数据量
+	assert.Equal(1, len(NewWindowFrames(opt, 10, 1)))
+}
+
+func TestNewThemePalette(t *testing.T) {
+	assert := assert.New(t)
+
+	theme := NewTheme(ThemeLight)
+	palette := newThemePalette(theme, drawing.ColorWhite, 5)
+	assert.True(len(palette) <= 256)
+	assert.Equal(drawing.ColorWhite, palette[0])
+	// 包含series的颜色
+	assert.Equal(theme.GetSeriesColor(0), palette[palette.Index(theme.GetSeriesColor(0))])
+
+	assert.Equal(Color{
+		R: 128,
+		G: 128,
+		B: 128,
+		A: 255,
+	}, blendColor(drawing.ColorWhite, drawing.ColorBlack, 0.5))
+}
+
+func TestRenderAnimatedGIF(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := RenderAnimatedGIF(AnimationOption{})
+	assert.NotNil(err)
+
+	opt := ChartOption{
+		Width:  300,
+		Height: 200,
+		XAxis: NewXAxisOption([]string{
+			"01",
+			"02",
+			"03",
+			"04",
+		}),
+		SeriesList: NewSeriesListDataFromValues([][]float64{
+			{120, 132, 101, 134},
+		}),
+	}
+	buf, err := RenderAnimatedGIF(AnimationOption{
+		Frames:    NewWindowFrames(opt, 2, 1),
+		Delay:     20,
+		LastDelay: 100,
+	}, ThemeOptionFunc(ThemeDark))
+	assert.Nil(err)
+	g, err := gif.DecodeAll(bytes.NewReader(buf))
+	assert.Nil(err)
+	assert.Equal(3, len(g.Image))
+	assert.Equal([]int{20, 20, 100}, g.Delay)
+	assert.Equal(0, g.LoopCount)
+	assert.Equal(300, g.Config.Width)
+	assert.Equal(200, g.Config.Height)
+	// 共享同一调色板
+	assert.Equal(g.Image[0].Palette, g.Image[2].Palette)
+
+	frames := NewWindowFrames(opt, 2, 2)
+	frames[1].Width = 400
+	_, err = RenderAnimatedGIF(AnimationOption{
+		Frames: frames,
+	})
+	assert.Equal("size of frames should be the same", err.Error())
+}
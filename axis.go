@@ -87,6 +87,12 @@ type AxisOption struct {
 func (a *axisPainter) Render() (Box, error) {
 	opt := a.opt
 	top := a.p
+	if opt.Position == PositionLeft || opt.Position == PositionRight {
+		top.startComponent("axis y-axis")
+	} else {
+		top.startComponent("axis x-axis")
+	}
+	defer top.endComponent()
 	theme := opt.Theme
 	if theme == nil {
 		theme = top.theme
@@ -284,9 +290,12 @@ func (a *axisPainter) Render() (Box, error) {
 		Position:     labelPosition,
 		TextRotation: opt.TextRotation,
 		Offset:       opt.LabelOffset,
+		axisLabel:    true,
 	})
 	// 显示辅助线
 	if opt.SplitLineShow {
+		top.startComponent("split-line")
+		defer top.endComponent()
 		style.StrokeColor = opt.SplitLineColor
 		style.StrokeWidth = 1
 		top.OverrideDrawingStyle(style)
@@ -0,0 +1,60 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// getBackdrop returns the opaque backdrop color of painter, default is white
+func (p *Painter) getBackdrop() Color {
+	if p.backdrop.IsZero() {
+		return drawing.ColorWhite
+	}
+	return p.backdrop.WithAlpha(255)
+}
+
+// isLightColor checks whether the color is light,
+// the translucent color is blended with the backdrop color first
+func (p *Painter) isLightColor(c Color) bool {
+	if c.A != 255 && !p.backdrop.IsZero() {
+		c = blendColor(p.getBackdrop(), c, float64(c.A)/255)
+	}
+	return isLightColor(c)
+}
+
+// flattenImage draws the image over the backdrop color,
+// it returns the image directly if it is opaque
+func flattenImage(img image.Image, backdrop Color) image.Image {
+	if o, ok := img.(interface{ Opaque() bool }); ok && o.Opaque() {
+		return img
+	}
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(bounds)
+	draw.Draw(rgba, bounds, image.NewUniform(backdrop.WithAlpha(255)), image.Point{}, draw.Src)
+	draw.Draw(rgba, bounds, img, bounds.Min, draw.Over)
+	return rgba
+}
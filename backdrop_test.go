@@ -0,0 +1,177 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"encoding/json"
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func TestPainterIsLightColor(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  100,
+		Height: 100,
+	})
+	assert.Nil(err)
+	c := drawing.ColorWhite.WithAlpha(20)
+	assert.True(p.isLightColor(c))
+	assert.Equal(drawing.ColorWhite, p.getBackdrop())
+
+	p.backdrop = drawing.ColorBlack
+	assert.False(p.isLightColor(c))
+	assert.True(p.Child().isLightColor(drawing.ColorWhite))
+}
+
+func TestFlattenImage(t *testing.T) {
+	assert := assert.New(t)
+
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(1, 0, color.RGBA{
+		R: 255,
+		A: 255,
+	})
+	result := flattenImage(img, drawing.ColorBlue)
+	assert.Equal(color.RGBA{
+		B: 255,
+		A: 255,
+	}, result.At(0, 0))
+	assert.Equal(color.RGBA{
+		R: 255,
+		A: 255,
+	}, result.At(1, 0))
+
+	// 不透明的图片直接返回
+	img.Set(0, 0, color.RGBA{
+		A: 255,
+	})
+	assert.Equal(img, flattenImage(img, drawing.ColorBlue))
+}
+
+func TestTransparentBackground(t *testing.T) {
+	assert := assert.New(t)
+
+	background := `<path  d="M 0 0
+L 600 0
+L 600 400
+L 0 400
+L 0 0" style="stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)"/>`
+	p, err := BarRender([][]float64{
+		{120},
+	},
+		SVGTypeOption(),
+		XAxisDataOptionFunc([]string{
+			"Mon",
+		}),
+	)
+	assert.Nil(err)
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	assert.True(strings.Contains(string(buf), background))
+
+	p, err = BarRender([][]float64{
+		{120},
+	},
+		SVGTypeOption(),
+		XAxisDataOptionFunc([]string{
+			"Mon",
+		}),
+		TransparentBackgroundOptionFunc(),
+	)
+	assert.Nil(err)
+	buf, err = p.Bytes()
+	assert.Nil(err)
+	assert.False(strings.Contains(string(buf), background))
+
+	backdrop := parseColor("#223344")
+	p, err = BarRender([][]float64{
+		{120},
+	},
+		PNGTypeOption(),
+		XAxisDataOptionFunc([]string{
+			"Mon",
+		}),
+		TransparentBackgroundOptionFunc(backdrop),
+	)
+	assert.Nil(err)
+	assert.Equal(backdrop, p.backdrop)
+	img, err := p.Image()
+	assert.Nil(err)
+	_, _, _, a := img.At(0, 0).RGBA()
+	assert.Equal(uint32(0), a)
+}
+
+func TestEChartsTransparentBackground(t *testing.T) {
+	assert := assert.New(t)
+
+	opt := EChartsOption{}
+	err := json.Unmarshal([]byte(`{
+		"backgroundColor": "transparent",
+		"backdropColor": "#223344"
+	}`), &opt)
+	assert.Nil(err)
+	o := opt.ToOption()
+	assert.True(o.TransparentBackground)
+	assert.Equal(parseColor("#223344"), o.BackdropColor)
+
+	opt = EChartsOption{
+		BackgroundColor: "rgb(10,20,30)",
+	}
+	o = opt.ToOption()
+	assert.False(o.TransparentBackground)
+	assert.Equal(Color{
+		R: 10,
+		G: 20,
+		B: 30,
+		A: 255,
+	}, o.BackgroundColor)
+}
+
+func TestBackdropTextColor(t *testing.T) {
+	assert := assert.New(t)
+
+	opt := ChartOption{
+		BackdropColor: parseColor("#223344"),
+	}
+	opt.fillDefault()
+	assert.Equal(defaultDarkFontColor, opt.theme.GetTextColor())
+
+	// 对比度足够时不调整
+	opt = ChartOption{
+		BackdropColor: drawing.ColorWhite,
+	}
+	opt.fillDefault()
+	assert.Equal(NewTheme(ThemeLight).GetTextColor(), opt.theme.GetTextColor())
+
+	opt = ChartOption{}
+	opt.fillDefault()
+	assert.Equal(opt.BackgroundColor, opt.BackdropColor)
+}
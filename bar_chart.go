@@ -130,6 +130,7 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 			rendererList = append(rendererList, labelPainter)
 		}
 
+		seriesPainter.startSeriesGroup(series)
 		for j, item := range series.Data {
 			if j >= xRange.divideCount {
 				continue
@@ -145,27 +146,31 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 			if !item.Style.FillColor.IsZero() {
 				fillColor = item.Style.FillColor
 			}
+			fillGradient := series.FillGradient
+			if item.FillGradient != nil {
+				fillGradient = item.FillGradient
+			}
+			seriesPainter.SetFillGradient(fillGradient)
 			top := barMaxHeight - h
+			barBox := chart.Box{
+				Top:    top,
+				Left:   x,
+				Right:  x + barWidth,
+				Bottom: barMaxHeight - 1,
+			}
 
 			if series.RoundRadius <= 0 {
 				seriesPainter.OverrideDrawingStyle(Style{
 					FillColor: fillColor,
-				}).Rect(chart.Box{
-					Top:    top,
-					Left:   x,
-					Right:  x + barWidth,
-					Bottom: barMaxHeight - 1,
-				})
+				}).Rect(barBox)
 			} else {
 				seriesPainter.OverrideDrawingStyle(Style{
 					FillColor: fillColor,
-				}).RoundedRect(chart.Box{
-					Top:    top,
-					Left:   x,
-					Right:  x + barWidth,
-					Bottom: barMaxHeight - 1,
-				}, series.RoundRadius)
+				}).RoundedRect(barBox, series.RoundRadius)
 			}
+			seriesPainter.SetFillGradient(nil)
+			seriesPainter.hitRect(barBox, seriesPainter.formatTooltip(series, getCategory(opt.XAxis.Data, j), item.Value))
+			seriesPainter.addSeriesRect(RegionTypeBar, series, j, item.Value, barBox)
 			// 用于生成marker point
 			points[j] = Point{
 				// 居中的位置
@@ -189,7 +194,7 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 				y = barMaxHeight
 				radians = -math.Pi / 2
 				if fontColor.IsZero() {
-					if isLightColor(fillColor) {
+					if seriesPainter.isLightColor(fillColor) {
 						fontColor = defaultLightFontColor
 					} else {
 						fontColor = defaultDarkFontColor
@@ -208,6 +213,7 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 				FontSize:  series.Label.FontSize,
 			})
 		}
+		seriesPainter.endGroup()
 
 		markPointPainter.Add(markPointRenderOption{
 			FillColor: seriesColor,
@@ -0,0 +1,346 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/golang/freetype/truetype"
+)
+
+type bulletChart struct {
+	p   *Painter
+	opt *BulletChartOption
+}
+
+type BulletData struct {
+	// The name of category
+	Name string
+	// The actual value
+	Value float64
+	// The target value, the target marker will be hidden if it is nil
+	Target *float64
+	// The upper bounds of qualitative ranges, e.g. poor, satisfactory and good
+	Ranges []float64
+}
+
+type BulletOption struct {
+	// The data list, one bullet for each category
+	Data []BulletData
+	// The orient of bullet, it can be horizontal or vertical, default is horizontal
+	Orient string
+	// The colors of ranges, the shades of text color will be used if it is nil
+	RangeColors []Color
+	// The color of actual value bar, default is the first series color
+	BarColor Color
+	// The color of target marker, default is the text color
+	TargetColor Color
+	// The flag for show actual value label
+	LabelShow bool
+}
+
+type BulletChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The x axis option
+	XAxis XAxisOption
+	// The padding of bullet chart
+	Padding Box
+	// The y axis option
+	YAxisOptions []YAxisOption
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The bullet option
+	Bullet BulletOption
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewBulletChart returns a bullet chart renderer
+func NewBulletChart(p *Painter, opt BulletChartOption) *bulletChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &bulletChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// IsVertical returns true if the orient of bullet is vertical
+func (opt *BulletOption) IsVertical() bool {
+	return opt.Orient == OrientVertical
+}
+
+// Names returns the category names of bullet
+func (opt *BulletOption) Names() []string {
+	names := make([]string, len(opt.Data))
+	for index, item := range opt.Data {
+		names[index] = item.Name
+	}
+	return names
+}
+
+// toSeriesList converts the bullet data to series list, each bullet item has one value
+// which is the max of value, target and ranges, it is used to calculate the value axis
+func (opt *BulletOption) toSeriesList() SeriesList {
+	values := make([]float64, 0, len(opt.Data))
+	for _, item := range opt.Data {
+		value := item.Value
+		if item.Target != nil && *item.Target > value {
+			value = *item.Target
+		}
+		for _, v := range item.Ranges {
+			if v > value {
+				value = v
+			}
+		}
+		values = append(values, value)
+	}
+	return SeriesList{
+		NewSeriesFromValues(values, ChartTypeBar),
+	}
+}
+
+// getRangeColors returns the colors of ranges,
+// the first range has the darkest color
+func (opt *BulletOption) getRangeColors(theme ColorPalette, count int) []Color {
+	colors := make([]Color, count)
+	textColor := theme.GetTextColor()
+	for index := range colors {
+		if index < len(opt.RangeColors) {
+			colors[index] = opt.RangeColors[index]
+			continue
+		}
+		alpha := 25 + 60*(count-index)/count
+		colors[index] = textColor.WithAlpha(uint8(alpha))
+	}
+	return colors
+}
+
+func (b *bulletChart) render(result *defaultRenderResult, opt BulletOption) (Box, error) {
+	count := len(opt.Data)
+	if count == 0 {
+		return BoxZero, errors.New("The data of bullet chart can not be nil")
+	}
+	p := b.p
+	theme := b.opt.Theme
+	seriesPainter := result.seriesPainter
+	isVertical := opt.IsVertical()
+
+	// 类目的区域及数值轴
+	var bands []int
+	var valueRange axisRange
+	if isVertical {
+		bands = autoDivide(seriesPainter.Width(), count)
+		valueRange = result.axisRanges[0]
+	} else {
+		categoryRange := result.axisRanges[0]
+		bands = categoryRange.AutoDivide()
+		max, min := opt.toSeriesList().GetMaxMin(0)
+		// 数值轴从0开始
+		max = math.Max(max, 0)
+		min = math.Min(min, 0)
+		valueRange = NewRange(AxisRangeOption{
+			Painter:     p,
+			Min:         min,
+			Max:         max,
+			DivideCount: defaultAxisDivideCount,
+			Size:        seriesPainter.Width(),
+		})
+	}
+	// 返回数值对应的位置
+	getPosition := func(value float64) int {
+		if isVertical {
+			return valueRange.getRestHeight(value)
+		}
+		return valueRange.getHeight(value)
+	}
+	// 返回数值区间对应的矩形
+	getBox := func(start, end int, from, to float64) Box {
+		v0 := getPosition(from)
+		v1 := getPosition(to)
+		if isVertical {
+			return Box{
+				Left:   start,
+				Right:  end,
+				Top:    v1,
+				Bottom: v0,
+			}
+		}
+		return Box{
+			Left:   v0,
+			Right:  v1,
+			Top:    start,
+			Bottom: end,
+		}
+	}
+
+	barColor := opt.BarColor
+	if barColor.IsZero() {
+		barColor = theme.GetSeriesColor(0)
+	}
+	targetColor := opt.TargetColor
+	if targetColor.IsZero() {
+		targetColor = theme.GetTextColor()
+	}
+	var labelPainter *SeriesLabelPainter
+	if opt.LabelShow {
+		labelPainter = NewSeriesLabelPainter(SeriesLabelPainterParams{
+			P:     seriesPainter,
+			Theme: theme,
+			Font:  b.opt.Font,
+		})
+	}
+	for index, item := range opt.Data {
+		// 水平方向时与横向柱状图一致，第一个类目展示在底部
+		bandIndex := index
+		if !isVertical {
+			bandIndex = count - index - 1
+		}
+		bandStart := bands[bandIndex]
+		bandSize := bands[bandIndex+1] - bandStart
+		margin := bandSize / 5
+		rangeStart := bandStart + margin
+		rangeEnd := bandStart + bandSize - margin
+
+		ranges := append([]float64{}, item.Ranges...)
+		sort.Float64s(ranges)
+		rangeColors := opt.getRangeColors(theme, len(ranges))
+		prev := float64(0)
+		for i, value := range ranges {
+			seriesPainter.OverrideDrawingStyle(Style{
+				FillColor: rangeColors[i],
+			}).Rect(getBox(rangeStart, rangeEnd, prev, value))
+			prev = value
+		}
+
+		// 实际值
+		barSize := (rangeEnd - rangeStart) / 3
+		barStart := rangeStart + barSize
+		barBox := getBox(barStart, barStart+barSize, 0, item.Value)
+		seriesPainter.OverrideDrawingStyle(Style{
+			FillColor: barColor,
+		}).Rect(barBox)
+
+		// 目标值
+		if item.Target != nil {
+			v := getPosition(*item.Target)
+			markerMargin := barSize / 2
+			points := []Point{
+				{
+					X: v,
+					Y: rangeStart + markerMargin,
+				},
+				{
+					X: v,
+					Y: rangeEnd - markerMargin,
+				},
+			}
+			if isVertical {
+				points = []Point{
+					{
+						X: rangeStart + markerMargin,
+						Y: v,
+					},
+					{
+						X: rangeEnd - markerMargin,
+						Y: v,
+					},
+				}
+			}
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeColor: targetColor,
+				StrokeWidth: 3,
+			}).LineStroke(points)
+		}
+
+		if labelPainter == nil {
+			continue
+		}
+		labelValue := LabelValue{
+			Index: 0,
+			Value: item.Value,
+		}
+		if isVertical {
+			labelValue.X = barBox.Left + barBox.Width()>>1
+			labelValue.Y = barBox.Top
+		} else {
+			labelValue.Orient = OrientHorizontal
+			labelValue.X = barBox.Right
+			labelValue.Y = barBox.Top + barBox.Height()>>1
+		}
+		labelPainter.Add(labelValue)
+	}
+	if labelPainter != nil {
+		_, err := labelPainter.Render()
+		if err != nil {
+			return BoxZero, err
+		}
+	}
+	return p.box, nil
+}
+
+func (b *bulletChart) Render() (Box, error) {
+	p := b.p
+	opt := b.opt
+	renderOpt := defaultRenderOption{
+		Theme:              opt.Theme,
+		Padding:            opt.Padding,
+		XAxis:              opt.XAxis,
+		YAxisOptions:       append([]YAxisOption{}, opt.YAxisOptions...),
+		TitleOption:        opt.Title,
+		LegendOption:       opt.Legend,
+		backgroundIsFilled: opt.backgroundIsFilled,
+	}
+	fillBulletRenderOption(&renderOpt, opt.Bullet)
+	renderResult, err := defaultRender(p, renderOpt)
+	if err != nil {
+		return BoxZero, err
+	}
+	return b.render(renderResult, opt.Bullet)
+}
+
+// fillBulletRenderOption sets the category axis of bullet
+func fillBulletRenderOption(renderOpt *defaultRenderOption, bullet BulletOption) {
+	names := bullet.Names()
+	renderOpt.SeriesList = bullet.toSeriesList()
+	renderOpt.valueFromZero = true
+	if bullet.IsVertical() {
+		renderOpt.XAxis.Data = names
+		return
+	}
+	if len(renderOpt.YAxisOptions) == 0 {
+		renderOpt.YAxisOptions = make([]YAxisOption, 1)
+	}
+	renderOpt.axisReversed = true
+	renderOpt.YAxisOptions[0].Data = names
+	renderOpt.YAxisOptions[0].DivideCount = len(names)
+	renderOpt.YAxisOptions[0].Unit = 1
+}
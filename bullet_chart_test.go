@@ -0,0 +1,175 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulletChart(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []BulletData{
+		{
+			Name:   "Revenue",
+			Value:  270,
+			Target: NewFloatPoint(250),
+			Ranges: []float64{
+				150,
+				225,
+				300,
+			},
+		},
+		{
+			Name:   "Customers",
+			Value:  140,
+			Target: NewFloatPoint(200),
+			Ranges: []float64{
+				250,
+				100,
+				180,
+			},
+		},
+	}
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewBulletChart(p, BulletChartOption{
+					Title: TitleOption{
+						Text: "Bullet",
+					},
+					Padding: Box{
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+						Left:   10,
+					},
+					Bullet: BulletOption{
+						Data:      data,
+						LabelShow: true,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Bullet</text><path  d=\"M 91 45\nL 96 45\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 91 202\nL 96 202\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 91 360\nL 96 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 96 45\nL 96 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"10\" y=\"130\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Customers</text><text x=\"27\" y=\"288\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Revenue</text><text x=\"92\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"169\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"247\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"330\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"412\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"494\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">300</text><text x=\"577\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360</text><path  d=\"M 178 45\nL 178 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 260 45\nL 260 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 343 45\nL 343 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 425 45\nL 425 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 507 45\nL 507 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 590 45\nL 590 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 96 233\nL 301 233\nL 301 329\nL 96 329\nL 96 233\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.3)\"/><path  d=\"M 301 233\nL 404 233\nL 404 329\nL 301 329\nL 301 233\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.3)\"/><path  d=\"M 404 233\nL 507 233\nL 507 329\nL 404 329\nL 404 233\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.2)\"/><path  d=\"M 96 265\nL 466 265\nL 466 297\nL 96 297\nL 96 265\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 439 249\nL 439 313\" style=\"stroke-width:3;stroke:rgba(70,70,70,1.0);fill:none\"/><path  d=\"M 96 76\nL 233 76\nL 233 171\nL 96 171\nL 96 76\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.3)\"/><path  d=\"M 233 76\nL 343 76\nL 343 171\nL 233 171\nL 233 76\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.3)\"/><path  d=\"M 343 76\nL 439 76\nL 439 171\nL 343 171\nL 343 76\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.2)\"/><path  d=\"M 96 107\nL 288 107\nL 288 138\nL 96 138\nL 96 107\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 370 91\nL 370 156\" style=\"stroke-width:3;stroke:rgba(70,70,70,1.0);fill:none\"/><text x=\"472\" y=\"286\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">270</text><text x=\"294\" y=\"127\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">140</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewBulletChart(p, BulletChartOption{
+					Padding: Box{
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+						Left:   10,
+					},
+					Bullet: BulletOption{
+						Data:   data,
+						Orient: OrientVertical,
+						RangeColors: []Color{
+							{
+								R: 200,
+								G: 200,
+								B: 200,
+								A: 255,
+							},
+						},
+						TargetColor: Color{
+							R: 238,
+							G: 102,
+							B: 102,
+							A: 255,
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"17\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">360</text><text x=\"10\" y=\"75\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">300</text><text x=\"10\" y=\"133\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"10\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"10\" y=\"250\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"19\" y=\"308\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"28\" y=\"367\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 47 10\nL 590 10\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 68\nL 590 68\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 126\nL 590 126\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 185\nL 590 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 243\nL 590 243\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 301\nL 590 301\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 365\nL 47 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 318 365\nL 318 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 590 365\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 47 360\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"153\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Revenue</text><text x=\"416\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Customers</text><path  d=\"M 101 215\nL 264 215\nL 264 360\nL 101 360\nL 101 215\" style=\"stroke-width:0;stroke:none;fill:rgba(200,200,200,1.0)\"/><path  d=\"M 101 142\nL 264 142\nL 264 215\nL 101 215\nL 101 142\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.3)\"/><path  d=\"M 101 69\nL 264 69\nL 264 142\nL 101 142\nL 101 69\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.2)\"/><path  d=\"M 155 98\nL 209 98\nL 209 360\nL 155 360\nL 155 98\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 128 117\nL 237 117\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:none\"/><path  d=\"M 372 263\nL 536 263\nL 536 360\nL 372 360\nL 372 263\" style=\"stroke-width:0;stroke:none;fill:rgba(200,200,200,1.0)\"/><path  d=\"M 372 185\nL 536 185\nL 536 263\nL 372 263\nL 372 185\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.3)\"/><path  d=\"M 372 117\nL 536 117\nL 536 185\nL 372 185\nL 372 117\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,0.2)\"/><path  d=\"M 426 224\nL 480 224\nL 480 360\nL 426 360\nL 426 224\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 399 166\nL 509 166\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:none\"/></svg>",
+		},
+	}
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
+
+func TestBulletChartVertical(t *testing.T) {
+	assert := assert.New(t)
+
+	bullet := BulletOption{
+		Orient: OrientVertical,
+		Data: []BulletData{
+			{
+				Name:   "A",
+				Value:  100,
+				Ranges: []float64{150},
+			},
+			{
+				Name:   "B",
+				Value:  120,
+				Ranges: []float64{150},
+			},
+			{
+				Name:   "C",
+				Value:  130,
+				Ranges: []float64{150},
+			},
+		},
+	}
+	// 每个类目仅一个数据
+	seriesList := bullet.toSeriesList()
+	assert.Equal(1, len(seriesList))
+	assert.Equal(3, len(seriesList[0].Data))
+
+	p, err := Render(ChartOption{
+		Type:   ChartOutputSVG,
+		Bullet: bullet,
+	})
+	assert.Nil(err)
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	data := string(buf)
+	assert.Equal(3, strings.Count(data, "fill:rgba(84,112,198,1.0)"))
+	for _, name := range bullet.Names() {
+		assert.Contains(data, ">"+name+"</text>")
+	}
+}
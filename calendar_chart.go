@@ -0,0 +1,305 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type calendarChart struct {
+	p   *Painter
+	opt *CalendarChartOption
+}
+
+type CalendarData struct {
+	// The date of data
+	Date time.Time
+	// The value of data
+	Value float64
+}
+
+type CalendarOption struct {
+	// The data of calendar
+	Data []CalendarData
+	// The start date of calendar, the min date of data will be used if it is zero
+	Start time.Time
+	// The end date of calendar, the max date of data will be used if it is zero
+	End time.Time
+	// The first day of week, default is sunday
+	WeekStart time.Weekday
+	// The size of cell, it will be calculated by the size of painter if it is 0
+	CellSize int
+	// The gap between cells, default is 2
+	CellGap int
+	// The color of cell without data
+	EmptyColor Color
+	// The flag for show month label, set this to *false will hide it
+	MonthLabelShow *bool
+	// The flag for show day label, set this to *false will hide it
+	DayLabelShow *bool
+	// The visual map of calendar
+	VisualMap VisualMapOption
+}
+
+type CalendarChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The padding of calendar chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The calendar option
+	Calendar CalendarOption
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewCalendarChart returns a calendar chart renderer
+func NewCalendarChart(p *Painter, opt CalendarChartOption) *calendarChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &calendarChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewCalendarData returns a calendar data list from the date values
+func NewCalendarData(values map[time.Time]float64) []CalendarData {
+	data := make([]CalendarData, 0, len(values))
+	for date, value := range values {
+		data = append(data, CalendarData{
+			Date:  date,
+			Value: value,
+		})
+	}
+	return data
+}
+
+// truncateDate returns the date without time(utc)
+func truncateDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// diffDays returns the days from start to end
+func diffDays(start, end time.Time) int {
+	return int(math.Round(end.Sub(start).Hours() / 24))
+}
+
+func (c *calendarChart) render(result *defaultRenderResult, opt CalendarOption) (Box, error) {
+	if len(opt.Data) == 0 && (opt.Start.IsZero() || opt.End.IsZero()) {
+		return BoxZero, errors.New("The data of calendar chart can not be nil")
+	}
+	theme := c.opt.Theme
+	seriesPainter := result.seriesPainter
+
+	values := make(map[time.Time]float64)
+	start := truncateDate(opt.Start)
+	end := truncateDate(opt.End)
+	min := math.MaxFloat64
+	max := -math.MaxFloat64
+	for _, item := range opt.Data {
+		date := truncateDate(item.Date)
+		if opt.Start.IsZero() && (start.IsZero() || date.Before(start)) {
+			start = date
+		}
+		if opt.End.IsZero() && (end.IsZero() || date.After(end)) {
+			end = date
+		}
+		values[date] += item.Value
+	}
+	for date, value := range values {
+		if date.Before(start) || date.After(end) {
+			continue
+		}
+		min = math.Min(min, value)
+		max = math.Max(max, value)
+	}
+	if end.Before(start) {
+		return BoxZero, errors.New("The end date of calendar should be after start date")
+	}
+	if min > max {
+		min = 0
+		max = 0
+	}
+	// 从每周的第一天开始
+	gridStart := start.AddDate(0, 0, -((int(start.Weekday()) - int(opt.WeekStart) + 7) % 7))
+	weeks := diffDays(gridStart, end)/7 + 1
+
+	seriesPainter.OverrideTextStyle(Style{
+		FontColor: theme.GetTextColor(),
+		FontSize:  labelFontSize,
+		Font:      c.opt.Font,
+	})
+	dayLabels := make([]string, 7)
+	for i := range dayLabels {
+		weekday := time.Weekday((int(opt.WeekStart) + i) % 7)
+		// 仅展示周一、周三、周五
+		if weekday == time.Monday ||
+			weekday == time.Wednesday ||
+			weekday == time.Friday {
+			dayLabels[i] = weekday.String()[0:3]
+		}
+	}
+	labelMargin := 5
+	dayLabelWidth := 0
+	if !isFalse(opt.DayLabelShow) {
+		w, _ := seriesPainter.MeasureTextMaxWidthHeight(dayLabels)
+		dayLabelWidth = w + labelMargin
+	}
+	monthLabelHeight := 0
+	if !isFalse(opt.MonthLabelShow) {
+		monthLabelHeight = seriesPainter.MeasureText("Jan").Height() + labelMargin
+	}
+	visualMapHeight := 0
+	vm := newVisualMap(opt.VisualMap, theme, min, max)
+	if !isFalse(opt.VisualMap.Show) {
+		visualMapHeight = 20
+	}
+
+	gap := opt.CellGap
+	if gap <= 0 {
+		gap = 2
+	}
+	cellSize := opt.CellSize
+	if cellSize <= 0 {
+		cellSize = chart.MinInt(
+			(seriesPainter.Width()-dayLabelWidth)/weeks,
+			(seriesPainter.Height()-monthLabelHeight-visualMapHeight)/7,
+		) - gap
+	}
+	if cellSize <= 0 {
+		return BoxZero, errors.New("The size of calendar cell should be gt 0")
+	}
+	unit := cellSize + gap
+
+	// 月份
+	if monthLabelHeight != 0 {
+		prevRight := -1
+		for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+			if date.Day() != 1 && !date.Equal(start) {
+				continue
+			}
+			x := dayLabelWidth + diffDays(gridStart, date)/7*unit
+			// 若该列已不是月份的第一周，则从下一列展示
+			if date.Day() != 1 && date.Weekday() != opt.WeekStart {
+				x += unit
+			}
+			text := date.Month().String()[0:3]
+			b := seriesPainter.MeasureText(text)
+			if x <= prevRight || x+b.Width() > seriesPainter.Width() {
+				continue
+			}
+			seriesPainter.Text(text, x, b.Height())
+			prevRight = x + b.Width() + labelMargin
+		}
+	}
+	// 星期
+	if dayLabelWidth != 0 {
+		for index, text := range dayLabels {
+			if text == "" {
+				continue
+			}
+			b := seriesPainter.MeasureText(text)
+			y := monthLabelHeight + index*unit + (cellSize+b.Height())>>1
+			seriesPainter.Text(text, 0, y)
+		}
+	}
+
+	emptyColor := opt.EmptyColor
+	if emptyColor.IsZero() {
+		emptyColor = theme.GetAxisSplitLineColor()
+	}
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		offset := diffDays(gridStart, date)
+		x := dayLabelWidth + offset/7*unit
+		y := monthLabelHeight + offset%7*unit
+		color := emptyColor
+		if value, ok := values[date]; ok {
+			if c, matched := vm.GetColor(value); matched {
+				color = c
+			}
+		}
+		seriesPainter.OverrideDrawingStyle(Style{
+			FillColor: color,
+		}).Rect(Box{
+			Left:   x,
+			Top:    y,
+			Right:  x + cellSize,
+			Bottom: y + cellSize,
+		})
+	}
+
+	if visualMapHeight != 0 {
+		// 展示在表格的右下方
+		width := dayLabelWidth + weeks*unit - gap
+		top := monthLabelHeight + 7*unit + labelMargin
+		_, err := newVisualMapPainter(seriesPainter.Child(PainterPaddingOption(Box{
+			Top:    top,
+			Right:  seriesPainter.Width() - width,
+			Bottom: chart.MaxInt(seriesPainter.Height()-top-visualMapHeight, 0),
+		})), vm, visualMapRenderOption{
+			Theme:     theme,
+			Font:      c.opt.Font,
+			FontSize:  opt.VisualMap.FontSize,
+			FontColor: opt.VisualMap.FontColor,
+		}).Render()
+		if err != nil {
+			return BoxZero, err
+		}
+	}
+
+	return c.p.box, nil
+}
+
+func (c *calendarChart) Render() (Box, error) {
+	p := c.p
+	opt := c.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:   opt.Theme,
+		Padding: opt.Padding,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption:        opt.Title,
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return c.render(renderResult, opt.Calendar)
+}
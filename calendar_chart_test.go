@@ -0,0 +1,101 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarChart(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2022, 1, 20, 0, 0, 0, 0, time.UTC)
+	values := make(map[time.Time]float64)
+	for i := 0; i < 21; i++ {
+		values[start.AddDate(0, 0, i)] = float64(i % 7)
+	}
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewCalendarChart(p, CalendarChartOption{
+					Title: TitleOption{
+						Text: "Calendar",
+					},
+					Calendar: CalendarOption{
+						Data: NewCalendarData(values),
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Calendar</text><text x=\"73\" y=\"47\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"115\" y=\"47\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"0\" y=\"120\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"0\" y=\"204\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"0\" y=\"288\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Fri</text><path  d=\"M 31 220\nL 71 220\nL 71 260\nL 31 260\nL 31 220\" style=\"stroke-width:0;stroke:none;fill:rgba(220,226,243,1.0)\"/><path  d=\"M 31 262\nL 71 262\nL 71 302\nL 31 302\nL 31 262\" style=\"stroke-width:0;stroke:none;fill:rgba(197,207,236,1.0)\"/><path  d=\"M 31 304\nL 71 304\nL 71 344\nL 31 344\nL 31 304\" style=\"stroke-width:0;stroke:none;fill:rgba(175,188,228,1.0)\"/><path  d=\"M 73 52\nL 113 52\nL 113 92\nL 73 92\nL 73 52\" style=\"stroke-width:0;stroke:none;fill:rgba(152,169,221,1.0)\"/><path  d=\"M 73 94\nL 113 94\nL 113 134\nL 73 134\nL 73 94\" style=\"stroke-width:0;stroke:none;fill:rgba(129,150,213,1.0)\"/><path  d=\"M 73 136\nL 113 136\nL 113 176\nL 73 176\nL 73 136\" style=\"stroke-width:0;stroke:none;fill:rgba(107,131,206,1.0)\"/><path  d=\"M 73 178\nL 113 178\nL 113 218\nL 73 218\nL 73 178\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 73 220\nL 113 220\nL 113 260\nL 73 260\nL 73 220\" style=\"stroke-width:0;stroke:none;fill:rgba(220,226,243,1.0)\"/><path  d=\"M 73 262\nL 113 262\nL 113 302\nL 73 302\nL 73 262\" style=\"stroke-width:0;stroke:none;fill:rgba(197,207,236,1.0)\"/><path  d=\"M 73 304\nL 113 304\nL 113 344\nL 73 344\nL 73 304\" style=\"stroke-width:0;stroke:none;fill:rgba(175,188,228,1.0)\"/><path  d=\"M 115 52\nL 155 52\nL 155 92\nL 115 92\nL 115 52\" style=\"stroke-width:0;stroke:none;fill:rgba(152,169,221,1.0)\"/><path  d=\"M 115 94\nL 155 94\nL 155 134\nL 115 134\nL 115 94\" style=\"stroke-width:0;stroke:none;fill:rgba(129,150,213,1.0)\"/><path  d=\"M 115 136\nL 155 136\nL 155 176\nL 115 176\nL 115 136\" style=\"stroke-width:0;stroke:none;fill:rgba(107,131,206,1.0)\"/><path  d=\"M 115 178\nL 155 178\nL 155 218\nL 115 218\nL 115 178\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 115 220\nL 155 220\nL 155 260\nL 115 260\nL 115 220\" style=\"stroke-width:0;stroke:none;fill:rgba(220,226,243,1.0)\"/><path  d=\"M 115 262\nL 155 262\nL 155 302\nL 115 302\nL 115 262\" style=\"stroke-width:0;stroke:none;fill:rgba(197,207,236,1.0)\"/><path  d=\"M 115 304\nL 155 304\nL 155 344\nL 115 344\nL 115 304\" style=\"stroke-width:0;stroke:none;fill:rgba(175,188,228,1.0)\"/><path  d=\"M 157 52\nL 197 52\nL 197 92\nL 157 92\nL 157 52\" style=\"stroke-width:0;stroke:none;fill:rgba(152,169,221,1.0)\"/><path  d=\"M 157 94\nL 197 94\nL 197 134\nL 157 134\nL 157 94\" style=\"stroke-width:0;stroke:none;fill:rgba(129,150,213,1.0)\"/><path  d=\"M 157 136\nL 197 136\nL 197 176\nL 157 176\nL 157 136\" style=\"stroke-width:0;stroke:none;fill:rgba(107,131,206,1.0)\"/><path  d=\"M 157 178\nL 197 178\nL 197 218\nL 157 218\nL 157 178\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"109\" y=\"369\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 122 359\nL 132 359\nL 132 369\nL 122 369\nL 122 359\" style=\"stroke-width:0;stroke:none;fill:rgba(220,226,243,1.0)\"/><path  d=\"M 135 359\nL 145 359\nL 145 369\nL 135 369\nL 135 359\" style=\"stroke-width:0;stroke:none;fill:rgba(186,198,232,1.0)\"/><path  d=\"M 148 359\nL 158 359\nL 158 369\nL 148 369\nL 148 359\" style=\"stroke-width:0;stroke:none;fill:rgba(152,169,221,1.0)\"/><path  d=\"M 161 359\nL 171 359\nL 171 369\nL 161 369\nL 161 359\" style=\"stroke-width:0;stroke:none;fill:rgba(118,141,209,1.0)\"/><path  d=\"M 174 359\nL 184 359\nL 184 369\nL 174 369\nL 174 359\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"189\" y=\"369\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewCalendarChart(p, CalendarChartOption{
+					Calendar: CalendarOption{
+						Data:      NewCalendarData(values),
+						WeekStart: time.Monday,
+						VisualMap: VisualMapOption{
+							Pieces: []VisualMapPiece{
+								{
+									Max:   NewFloatPoint(2),
+									Color: Color{R: 198, G: 228, B: 139, A: 255},
+								},
+								{
+									Min:   NewFloatPoint(2),
+									Color: Color{R: 33, G: 110, B: 57, A: 255},
+								},
+							},
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"78\" y=\"12\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"125\" y=\"12\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"0\" y=\"45\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"0\" y=\"139\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"0\" y=\"233\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Fri</text><path  d=\"M 31 158\nL 76 158\nL 76 203\nL 31 203\nL 31 158\" style=\"stroke-width:0;stroke:none;fill:rgba(198,228,139,1.0)\"/><path  d=\"M 31 205\nL 76 205\nL 76 250\nL 31 250\nL 31 205\" style=\"stroke-width:0;stroke:none;fill:rgba(198,228,139,1.0)\"/><path  d=\"M 31 252\nL 76 252\nL 76 297\nL 31 297\nL 31 252\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 31 299\nL 76 299\nL 76 344\nL 31 344\nL 31 299\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 78 17\nL 123 17\nL 123 62\nL 78 62\nL 78 17\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 78 64\nL 123 64\nL 123 109\nL 78 109\nL 78 64\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 78 111\nL 123 111\nL 123 156\nL 78 156\nL 78 111\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 78 158\nL 123 158\nL 123 203\nL 78 203\nL 78 158\" style=\"stroke-width:0;stroke:none;fill:rgba(198,228,139,1.0)\"/><path  d=\"M 78 205\nL 123 205\nL 123 250\nL 78 250\nL 78 205\" style=\"stroke-width:0;stroke:none;fill:rgba(198,228,139,1.0)\"/><path  d=\"M 78 252\nL 123 252\nL 123 297\nL 78 297\nL 78 252\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 78 299\nL 123 299\nL 123 344\nL 78 344\nL 78 299\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 125 17\nL 170 17\nL 170 62\nL 125 62\nL 125 17\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 125 64\nL 170 64\nL 170 109\nL 125 109\nL 125 64\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 125 111\nL 170 111\nL 170 156\nL 125 156\nL 125 111\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 125 158\nL 170 158\nL 170 203\nL 125 203\nL 125 158\" style=\"stroke-width:0;stroke:none;fill:rgba(198,228,139,1.0)\"/><path  d=\"M 125 205\nL 170 205\nL 170 250\nL 125 250\nL 125 205\" style=\"stroke-width:0;stroke:none;fill:rgba(198,228,139,1.0)\"/><path  d=\"M 125 252\nL 170 252\nL 170 297\nL 125 297\nL 125 252\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 125 299\nL 170 299\nL 170 344\nL 125 344\nL 125 299\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 172 17\nL 217 17\nL 217 62\nL 172 62\nL 172 17\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 172 64\nL 217 64\nL 217 109\nL 172 109\nL 172 64\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 172 111\nL 217 111\nL 217 156\nL 172 156\nL 172 111\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><path  d=\"M 139 359\nL 149 359\nL 149 369\nL 139 369\nL 139 359\" style=\"stroke-width:0;stroke:none;fill:rgba(198,228,139,1.0)\"/><text x=\"154\" y=\"369\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">&lt; 2</text><path  d=\"M 179 359\nL 189 359\nL 189 369\nL 179 369\nL 179 359\" style=\"stroke-width:0;stroke:none;fill:rgba(33,110,57,1.0)\"/><text x=\"194\" y=\"369\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">≥ 2</text></svg>",
+		},
+	}
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
@@ -23,6 +23,7 @@
 package charts
 
 import (
+	"errors"
 	"sort"
 
 	"github.com/golang/freetype/truetype"
@@ -31,7 +32,7 @@ import (
 type ChartOption struct {
 	theme ColorPalette
 	font  *truetype.Font
-	// The output type of chart, "svg" or "png", default value is "svg"
+	// The output type of chart, "svg", "png", "jpeg", "gif", "pdf", "html" or "terminal", default value is "svg"
 	Type string
 	// The font family, which should be installed first
 	FontFamily string
@@ -50,7 +51,10 @@ type ChartOption struct {
 	Width int
 	// The height of chart, default height is 400
 	Height int
-	Parent *Painter
+	// The device pixel ratio of raster output, e.g. 2 renders a 600x400 chart to 1200x800 png.
+	// It is ignored by svg and pdf output
+	PixelRatio float64
+	Parent     *Painter
 	// The padding for chart, default padding is [20, 10, 10, 10]
 	Padding Box
 	// The canvas box for chart
@@ -59,8 +63,29 @@ type ChartOption struct {
 	SeriesList SeriesList
 	// The radar indicator list
 	RadarIndicators []RadarIndicator
+	// The polar option
+	Polar PolarOption
+	// The parallel option
+	Parallel ParallelOption
+	// The sunburst option
+	Sunburst SunburstOption
+	// The calendar option
+	Calendar CalendarOption
+	// The gantt option
+	Gantt GanttOption
+	// The graph option
+	Graph GraphOption
+	// The bullet option
+	Bullet BulletOption
+	// The sparkline option
+	Sparkline SparklineOption
 	// The background color of chart
 	BackgroundColor Color
+	// The flag for transparent background, the background of chart will not be drawn
+	TransparentBackground bool
+	// The assumed color behind the chart, it is used to choose the contrast color
+	// of text and label. The default value is the background color
+	BackdropColor Color
 	// The flag for show symbol of line, set this to *false will hide symbol
 	SymbolShow *bool
 	// The stroke width of line chart
@@ -79,6 +104,12 @@ type ChartOption struct {
 	Children []ChartOption
 	// The value formatter
 	ValueFormatter ValueFormatter
+	// The accessible option of svg output
+	Accessible AccessibleOption
+	// The semantic and stylesheet option of svg output
+	SVG SVGOption
+	// The size and color option of terminal output
+	Terminal TerminalOption
 }
 
 // OptionFunc option function
@@ -94,6 +125,31 @@ func PNGTypeOption() OptionFunc {
 	return TypeOptionFunc(ChartOutputPNG)
 }
 
+// PDFTypeOption set pdf type of chart's output
+func PDFTypeOption() OptionFunc {
+	return TypeOptionFunc(ChartOutputPDF)
+}
+
+// JPEGTypeOption set jpeg type of chart's output
+func JPEGTypeOption() OptionFunc {
+	return TypeOptionFunc(ChartOutputJPEG)
+}
+
+// GIFTypeOption set gif type of chart's output
+func GIFTypeOption() OptionFunc {
+	return TypeOptionFunc(ChartOutputGIF)
+}
+
+// HTMLTypeOption set html type of chart's output
+func HTMLTypeOption() OptionFunc {
+	return TypeOptionFunc(ChartOutputHTML)
+}
+
+// TerminalTypeOption set terminal type of chart's output
+func TerminalTypeOption() OptionFunc {
+	return TypeOptionFunc(ChartOutputTerminal)
+}
+
 // TypeOptionFunc set type of chart's output
 func TypeOptionFunc(t string) OptionFunc {
 	return func(opt *ChartOption) {
@@ -181,6 +237,13 @@ func WidthOptionFunc(width int) OptionFunc {
 	}
 }
 
+// PixelRatioOptionFunc set device pixel ratio of chart's raster output
+func PixelRatioOptionFunc(ratio float64) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.PixelRatio = ratio
+	}
+}
+
 // HeightOptionFunc set height of chart
 func HeightOptionFunc(height int) OptionFunc {
 	return func(opt *ChartOption) {
@@ -228,6 +291,81 @@ func RadarIndicatorOptionFunc(names []string, values []float64) OptionFunc {
 	}
 }
 
+// PolarOptionFunc set polar of chart
+func PolarOptionFunc(polar PolarOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Polar = polar
+	}
+}
+
+// ParallelOptionFunc set parallel of chart
+func ParallelOptionFunc(parallel ParallelOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Parallel = parallel
+	}
+}
+
+// ParallelAxesOptionFunc set parallel axes of chart
+func ParallelAxesOptionFunc(names []string) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Parallel.Axes = NewParallelAxes(names)
+	}
+}
+
+// SunburstOptionFunc set sunburst of chart
+func SunburstOptionFunc(sunburst SunburstOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Sunburst = sunburst
+	}
+}
+
+// CalendarOptionFunc set calendar of chart
+func CalendarOptionFunc(calendar CalendarOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Calendar = calendar
+	}
+}
+
+// GanttOptionFunc set gantt of chart
+func GanttOptionFunc(gantt GanttOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Gantt = gantt
+	}
+}
+
+// GraphOptionFunc set graph of chart
+func GraphOptionFunc(graph GraphOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Graph = graph
+	}
+}
+
+// BulletOptionFunc set bullet of chart
+func BulletOptionFunc(bullet BulletOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Bullet = bullet
+	}
+}
+
+// SparklineOptionFunc set sparkline of chart
+func SparklineOptionFunc(sparkline SparklineOption) OptionFunc {
+	return func(opt *ChartOption) {
+		sparkline.Enabled = true
+		opt.Sparkline = sparkline
+	}
+}
+
+// TransparentBackgroundOptionFunc set transparent background of chart,
+// the backdrop color is the assumed color behind the chart
+func TransparentBackgroundOptionFunc(backdropColor ...Color) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.TransparentBackground = true
+		if len(backdropColor) != 0 {
+			opt.BackdropColor = backdropColor[0]
+		}
+	}
+}
+
 // BackgroundColorOptionFunc set background color of chart
 func BackgroundColorOptionFunc(color Color) OptionFunc {
 	return func(opt *ChartOption) {
@@ -265,6 +403,15 @@ func (o *ChartOption) fillDefault() {
 			axisCount++
 		}
 	}
+	// 终端输出以行列数计算宽高
+	if o.Type == ChartOutputTerminal {
+		if o.Terminal.Columns > 0 {
+			o.Width = o.Terminal.Columns * terminalCellWidth
+		}
+		if o.Terminal.Rows > 0 {
+			o.Height = o.Terminal.Rows * terminalCellHeight
+		}
+	}
 	o.Width = getDefaultInt(o.Width, defaultChartWidth)
 	o.Height = getDefaultInt(o.Height, defaultChartHeight)
 	yAxisOptions := make([]YAxisOption, axisCount)
@@ -281,7 +428,19 @@ func (o *ChartOption) fillDefault() {
 	if o.BackgroundColor.IsZero() {
 		o.BackgroundColor = t.GetBackgroundColor()
 	}
-	if o.Padding.IsZero() {
+	if o.BackdropColor.IsZero() {
+		o.BackdropColor = o.BackgroundColor
+	} else if isLightColor(o.BackdropColor) == isLightColor(t.GetTextColor()) {
+		// 文本颜色与背景对比度不足时，根据背景调整
+		if isLightColor(o.BackdropColor) {
+			t.SetTextColor(defaultLightFontColor)
+		} else {
+			t.SetTextColor(defaultDarkFontColor)
+		}
+	}
+	if o.Sparkline.Enabled {
+		o.fillSparklineDefault()
+	} else if o.Padding.IsZero() {
 		o.Padding = Box{
 			Top:    20,
 			Right:  20,
@@ -311,6 +470,35 @@ func (o *ChartOption) fillDefault() {
 	}
 }
 
+// fillSparklineDefault fills the default option of sparkline,
+// the x axis is filled by the count of series data
+func (o *ChartOption) fillSparklineDefault() {
+	// 仅展示高亮点时预留空白，避免点超出画布
+	if o.Padding.IsZero() && o.Sparkline.hasHighlight() {
+		o.Padding = Box{
+			Top:    defaultSparklinePadding,
+			Right:  defaultSparklinePadding,
+			Bottom: defaultSparklinePadding,
+			Left:   defaultSparklinePadding,
+		}
+	}
+	if len(o.XAxis.Data) == 0 {
+		count := 0
+		for _, series := range o.SeriesList {
+			if len(series.Data) > count {
+				count = len(series.Data)
+			}
+		}
+		o.XAxis.Data = make([]string, count)
+	}
+	if o.XAxis.BoundaryGap == nil {
+		o.XAxis.BoundaryGap = FalseFlag()
+	}
+	if o.SymbolShow == nil {
+		o.SymbolShow = FalseFlag()
+	}
+}
+
 // LineRender line chart render
 func LineRender(values [][]float64, opts ...OptionFunc) (*Painter, error) {
 	seriesList := NewSeriesListDataFromValues(values, ChartTypeLine)
@@ -358,6 +546,90 @@ func FunnelRender(values []float64, opts ...OptionFunc) (*Painter, error) {
 	}, opts...)
 }
 
+// PolarBarRender polar bar chart render
+func PolarBarRender(values [][]float64, opts ...OptionFunc) (*Painter, error) {
+	seriesList := NewSeriesListDataFromValues(values, ChartTypePolarBar)
+	return Render(ChartOption{
+		SeriesList: seriesList,
+	}, opts...)
+}
+
+// PolarLineRender polar line chart render
+func PolarLineRender(values [][]float64, opts ...OptionFunc) (*Painter, error) {
+	seriesList := NewSeriesListDataFromValues(values, ChartTypePolarLine)
+	return Render(ChartOption{
+		SeriesList: seriesList,
+	}, opts...)
+}
+
+// ParallelRender parallel coordinates chart render
+func ParallelRender(values [][]float64, opts ...OptionFunc) (*Painter, error) {
+	seriesList := NewSeriesListDataFromValues(values, ChartTypeParallel)
+	return Render(ChartOption{
+		SeriesList: seriesList,
+	}, opts...)
+}
+
+// SunburstRender sunburst chart render
+func SunburstRender(data []SunburstData, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		Sunburst: SunburstOption{
+			Data: data,
+		},
+	}, opts...)
+}
+
+// CalendarRender calendar chart render
+func CalendarRender(data []CalendarData, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		Calendar: CalendarOption{
+			Data: data,
+		},
+	}, opts...)
+}
+
+// GanttRender gantt chart render
+func GanttRender(data []GanttItem, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		Gantt: GanttOption{
+			Data: data,
+		},
+	}, opts...)
+}
+
+// GraphRender graph chart render
+func GraphRender(nodes []GraphNode, links []GraphLink, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		Graph: GraphOption{
+			Nodes: nodes,
+			Links: links,
+		},
+	}, opts...)
+}
+
+// BulletRender bullet chart render
+func BulletRender(data []BulletData, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		Bullet: BulletOption{
+			Data: data,
+		},
+	}, opts...)
+}
+
+// SparklineRender sparkline chart render, title, legend and axes are hidden
+func SparklineRender(values []float64, width, height int, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		Width:  width,
+		Height: height,
+		SeriesList: SeriesList{
+			NewSeriesFromValues(values, ChartTypeLine),
+		},
+		Sparkline: SparklineOption{
+			Enabled: true,
+		},
+	}, opts...)
+}
+
 // TableRender table chart render
 func TableRender(header []string, data [][]string, spanMaps ...map[int]int) (*Painter, error) {
 	opt := TableChartOption{
@@ -379,11 +651,13 @@ func TableRender(header []string, data [][]string, spanMaps ...map[int]int) (*Pa
 	return TableOptionRender(opt)
 }
 
-// TableOptionRender table render with option
-func TableOptionRender(opt TableChartOption) (*Painter, error) {
+// measureTableOption fills the default value of table option and returns the layout of table,
+// the cell content is not rendered while measuring
+func measureTableOption(opt *TableChartOption) (*tableLayout, error) {
 	if opt.Type == "" {
 		opt.Type = ChartOutputPNG
 	}
+	fitWidth := opt.FitColumnWidth && opt.Width <= 0
 	if opt.Width <= 0 {
 		opt.Width = defaultChartWidth
 	}
@@ -394,33 +668,136 @@ func TableOptionRender(opt TableChartOption) (*Painter, error) {
 		opt.Font, _ = GetDefaultFont()
 	}
 
-	p, err := NewPainter(PainterOptions{
-		Type:  opt.Type,
-		Width: opt.Width,
-		// 仅用于计算表格高度，因此随便设置即可
-		Height: 100,
-		Font:   opt.Font,
-	})
+	measure := func() (*tableLayout, error) {
+		p, err := NewPainter(PainterOptions{
+			Type:  opt.Type,
+			Width: opt.Width,
+			// 仅用于计算表格高度，因此随便设置即可
+			Height:           100,
+			Font:             opt.Font,
+			DevicePixelRatio: opt.PixelRatio,
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer p.Release()
+		return NewTableChart(p, *opt).layout()
+	}
+	layout, err := measure()
 	if err != nil {
 		return nil, err
 	}
-	info, err := NewTableChart(p, opt).render()
+	// 宽度按内容调整后重新计算高度
+	if fitWidth && layout.info.ContentWidth != opt.Width {
+		opt.Width = layout.info.ContentWidth
+		layout, err = measure()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return layout, nil
+}
+
+// TableOptionRender table render with option
+func TableOptionRender(opt TableChartOption) (*Painter, error) {
+	layout, err := measureTableOption(&opt)
 	if err != nil {
 		return nil, err
 	}
+	info := layout.info
 
-	p, err = NewPainter(PainterOptions{
-		Type:   opt.Type,
-		Width:  info.Width,
-		Height: info.Height,
-		Font:   opt.Font,
+	p, err := NewPainter(PainterOptions{
+		Type:             opt.Type,
+		Width:            info.Width,
+		Height:           info.Height,
+		Font:             opt.Font,
+		DevicePixelRatio: opt.PixelRatio,
 	})
 	if err != nil {
 		return nil, err
 	}
-	_, err = NewTableChart(p, opt).renderWithInfo(info)
+	_, err = NewTableChart(p, opt).renderWithLayout(layout)
 	if err != nil {
 		return nil, err
 	}
 	return p, nil
 }
+
+// TableOptionRenderPages table render with option, the data is split into pages
+// by MaxHeight and RowsPerPage, and the header is repeated on each page
+func TableOptionRenderPages(opt TableChartOption) ([]*Painter, error) {
+	layout, err := measureTableOption(&opt)
+	if err != nil {
+		return nil, err
+	}
+	info := layout.info
+	for _, item := range opt.MergedCells {
+		if item.Row < 0 || item.RowSpan < 0 {
+			return nil, errors.New("row and row span of merged cell can not be negative")
+		}
+	}
+	// 合并的行不可拆分到不同页
+	units := make([]int, len(opt.Data))
+	for index := range units {
+		units[index] = index
+	}
+	for _, item := range opt.MergedCells {
+		if item.Row < 1 {
+			continue
+		}
+		for i := item.Row; i < item.Row-1+item.RowSpan && i < len(units); i++ {
+			units[i] = units[item.Row-1]
+		}
+	}
+	// 按行数与高度分页
+	pages := make([][2]int, 0)
+	start := 0
+	height := info.HeaderHeight
+	for index := 0; index < len(units); {
+		end := index + 1
+		unitHeight := info.RowHeights[index]
+		for end < len(units) && units[end] == units[index] {
+			unitHeight += info.RowHeights[end]
+			end++
+		}
+		if index != start &&
+			((opt.RowsPerPage > 0 && end-start > opt.RowsPerPage) ||
+				(opt.MaxHeight > 0 && height+unitHeight > opt.MaxHeight)) {
+			pages = append(pages, [2]int{start, index})
+			start = index
+			height = info.HeaderHeight
+		}
+		height += unitHeight
+		index = end
+	}
+	if start < len(units) || len(pages) == 0 {
+		pages = append(pages, [2]int{start, len(units)})
+	}
+
+	result := make([]*Painter, 0, len(pages))
+	for _, page := range pages {
+		pageOpt := opt
+		pageOpt.Data = opt.Data[page[0]:page[1]]
+		pageOpt.rowOffset = page[0]
+		// 各页使用相同的列宽与行高
+		pageOpt.columnWidths = info.ColumnWidths
+		pageOpt.rowHeights = info.RowHeights[page[0]:page[1]]
+		pageOpt.MergedCells = make([]TableMergedCell, 0)
+		for _, item := range opt.MergedCells {
+			if item.Row == 0 {
+				pageOpt.MergedCells = append(pageOpt.MergedCells, item)
+				continue
+			}
+			if item.Row > 0 && item.Row-1 >= page[0] && item.Row-1 < page[1] {
+				item.Row -= page[0]
+				pageOpt.MergedCells = append(pageOpt.MergedCells, item)
+			}
+		}
+		p, err := TableOptionRender(pageOpt)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
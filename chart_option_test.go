@@ -23,6 +23,8 @@
 package charts
 
 import (
+	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -449,3 +451,190 @@ func TestFunnelRender(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 86 29\nL 116 29\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"101\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"118\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Show</text><path  d=\"M 176 29\nL 206 29\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"191\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"208\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Click</text><path  d=\"M 262 29\nL 292 29\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><circle cx=\"277\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"294\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Visit</text><path  d=\"M 345 29\nL 375 29\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><circle cx=\"360\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"377\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Inquiry</text><path  d=\"M 444 29\nL 474 29\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><circle cx=\"459\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><text x=\"476\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Order</text><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Funnel</text><path  d=\"M 20 55\nL 580 55\nL 524 112\nL 76 112\nL 20 55\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"264\" y=\"83\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Show(100%)</text><path  d=\"M 76 114\nL 524 114\nL 468 171\nL 132 171\nL 76 114\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"269\" y=\"142\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Click(80%)</text><path  d=\"M 132 173\nL 468 173\nL 412 230\nL 188 230\nL 132 173\" style=\"stroke-width:0;stroke:none;fill:rgba(250,200,88,1.0)\"/><text x=\"271\" y=\"201\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Visit(60%)</text><path  d=\"M 188 232\nL 412 232\nL 356 289\nL 244 289\nL 188 232\" style=\"stroke-width:0;stroke:none;fill:rgba(238,102,102,1.0)\"/><text x=\"264\" y=\"260\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Inquiry(40%)</text><path  d=\"M 244 291\nL 356 291\nL 300 348\nL 300 348\nL 244 291\" style=\"stroke-width:0;stroke:none;fill:rgba(115,192,222,1.0)\"/><text x=\"268\" y=\"319\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Order(20%)</text></svg>", string(data))
 }
+
+func TestSparklineRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := SparklineRender(
+		[]float64{
+			5,
+			7,
+			3,
+			9,
+			12,
+			8,
+		},
+		120,
+		30,
+		SVGTypeOption(),
+		SparklineOptionFunc(SparklineOption{
+			LastShow: true,
+			MinShow:  true,
+			MaxShow:  true,
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"120\" height=\"30\">\\n<path  d=\"M 0 0\nL 120 0\nL 120 30\nL 0 30\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 3 22\nL 25 17\nL 48 27\nL 71 11\nL 94 3\nL 117 14\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"48\" cy=\"27\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><circle cx=\"94\" cy=\"3\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"117\" cy=\"14\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/></svg>", string(data))
+}
+
+func TestSparklineRenderEmpty(t *testing.T) {
+	assert := assert.New(t)
+
+	background := "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"120\" height=\"30\">\\n<path  d=\"M 0 0\nL 120 0\nL 120 30\nL 0 30\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/></svg>"
+	// 无数据或全为空值时仅有背景
+	for _, values := range [][]float64{
+		nil,
+		{},
+		{
+			GetNullValue(),
+			GetNullValue(),
+		},
+	} {
+		p, err := SparklineRender(values, 120, 30, SVGTypeOption())
+		assert.Nil(err)
+		data, err := p.Bytes()
+		assert.Nil(err)
+		assert.Equal(background, string(data))
+	}
+}
+
+func TestTableOptionRenderPages(t *testing.T) {
+	assert := assert.New(t)
+
+	data := make([][]string, 0)
+	for i := 0; i < 7; i++ {
+		data = append(data, []string{
+			"Item",
+			"Description",
+			strconv.Itoa(i),
+		})
+	}
+	pages, err := TableOptionRenderPages(TableChartOption{
+		Type: ChartOutputSVG,
+		Header: []string{
+			"Name",
+			"Description",
+			"Value",
+		},
+		Data:           data,
+		FitColumnWidth: true,
+		RowsPerPage:    3,
+		MergedCells: []TableMergedCell{
+			{
+				Row:     3,
+				Column:  0,
+				RowSpan: 2,
+			},
+		},
+	})
+	assert.Nil(err)
+	// 合并的行在同一页
+	assert.Equal(3, len(pages))
+	heights := make([]int, 0)
+	for _, p := range pages {
+		assert.Equal(pages[0].Width(), p.Width())
+		heights = append(heights, p.Height())
+	}
+	assert.Equal([]int{
+		105,
+		140,
+		105,
+	}, heights)
+	assert.True(pages[0].Width() < defaultChartWidth)
+
+	pages, err = TableOptionRenderPages(TableChartOption{
+		Type: ChartOutputSVG,
+		Header: []string{
+			"Name",
+			"Description",
+			"Value",
+		},
+		Data:      data,
+		MaxHeight: 200,
+	})
+	assert.Nil(err)
+	assert.Equal(2, len(pages))
+	assert.Equal(defaultChartWidth, pages[0].Width())
+	assert.True(pages[0].Height() <= 200)
+}
+
+func TestTableOptionRenderPagesLayout(t *testing.T) {
+	assert := assert.New(t)
+
+	opt := TableChartOption{
+		Type: ChartOutputSVG,
+		Header: []string{
+			"Name",
+			"Value",
+		},
+		Data: [][]string{
+			{
+				"A very long long long long name",
+				"1",
+			},
+			{
+				"B",
+				"2",
+			},
+			{
+				"C",
+				"3",
+			},
+			{
+				"D",
+				"a very long long long value",
+			},
+		},
+		FitColumnWidth: true,
+		RowsPerPage:    2,
+	}
+	pages, err := TableOptionRenderPages(opt)
+	assert.Nil(err)
+	assert.Equal(2, len(pages))
+	// 各页的列宽一致
+	reg := regexp.MustCompile(`<text x="(\d+)"[^>]*>Value<`)
+	columns := make([]string, 0)
+	for _, p := range pages {
+		buf, err := p.Bytes()
+		assert.Nil(err)
+		result := reg.FindStringSubmatch(string(buf))
+		assert.Equal(2, len(result))
+		columns = append(columns, result[1])
+		assert.Equal(pages[0].Width(), p.Width())
+		assert.Equal(pages[0].Height(), p.Height())
+	}
+	assert.Equal(columns[0], columns[1])
+
+	// 合并单元格的行不可为负数
+	opt.MergedCells = []TableMergedCell{
+		{
+			Row:     -1,
+			RowSpan: 3,
+		},
+	}
+	_, err = TableOptionRenderPages(opt)
+	assert.Equal("row and row span of merged cell can not be negative", err.Error())
+}
+
+func TestSparklineRenderNoPadding(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := SparklineRender(
+		[]float64{
+			5,
+			7,
+			3,
+			9,
+		},
+		120,
+		30,
+		SVGTypeOption(),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	// 无高亮点时占满画布
+	assert.Contains(string(data), "<path  d=\"M 0 20\nL 40 10\nL 80 30\nL 120 0\" style=\"stroke-width:2;")
+}
@@ -106,6 +106,10 @@ type defaultRenderOption struct {
 	backgroundIsFilled bool
 	// x y axis is reversed
 	axisReversed bool
+	// sparkline mode, title, legend and axes are not rendered
+	sparkline bool
+	// the value axis starts from 0
+	valueFromZero bool
 }
 
 type defaultRenderResult struct {
@@ -125,7 +129,43 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 		p = p.Child(PainterPaddingOption(opt.Padding))
 	}
 
+	result := defaultRenderResult{
+		axisRanges: make(map[int]axisRange),
+	}
+	if opt.sparkline {
+		for _, index := range seriesList.axisIndexes() {
+			max, min := seriesList.GetMaxMin(index)
+			// 使用数据的最大最小值，使曲线占满画布
+			if max == min {
+				max++
+				min--
+			}
+			r := NewRange(AxisRangeOption{
+				Painter:     p,
+				Min:         min,
+				Max:         max,
+				Size:        p.Height(),
+				DivideCount: defaultAxisDivideCount,
+			})
+			r.min = min
+			r.max = max
+			if len(opt.YAxisOptions) > index {
+				yAxisOption := opt.YAxisOptions[index]
+				if yAxisOption.Min != nil {
+					r.min = *yAxisOption.Min
+				}
+				if yAxisOption.Max != nil {
+					r.max = *yAxisOption.Max
+				}
+			}
+			result.axisRanges[index] = r
+		}
+		result.seriesPainter = p
+		return &result, nil
+	}
+
 	legendHeight := 0
+	headerHeight := 0
 	if len(opt.LegendOption.Data) != 0 {
 		if opt.LegendOption.Theme == nil {
 			opt.LegendOption.Theme = opt.Theme
@@ -154,24 +194,28 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 		if opt.LegendOption.Orient == OrientVertical {
 			top = titleBox.Height()
 		}
-		p = p.Child(PainterPaddingOption(Box{
-			// 标题下留白
-			Top: top + 20,
-		}))
+		headerHeight = top
+		if !p.isTerminal() {
+			p = p.Child(PainterPaddingOption(Box{
+				// 标题下留白
+				Top: top + 20,
+			}))
+		}
 	}
-
-	result := defaultRenderResult{
-		axisRanges: make(map[int]axisRange),
+	// 终端中标题与图例需与图表区域间隔一行，避免文本覆盖
+	if p.isTerminal() {
+		if opt.LegendOption.Orient != OrientVertical {
+			headerHeight = chart.MaxInt(headerHeight, legendHeight)
+		}
+		if headerHeight > 0 {
+			p = p.Child(PainterPaddingOption(Box{
+				Top: p.getTerminalHeaderPadding(headerHeight),
+			}))
+		}
 	}
 
 	// 计算图表对应的轴有哪些
-	axisIndexList := make([]int, 0)
-	for _, series := range opt.SeriesList {
-		if containsInt(axisIndexList, series.AxisIndex) {
-			continue
-		}
-		axisIndexList = append(axisIndexList, series.AxisIndex)
-	}
+	axisIndexList := seriesList.axisIndexes()
 	// 高度需要减去x轴的高度
 	rangeHeight := p.Height() - defaultXAxisHeight
 	rangeWidthLeft := 0
@@ -190,7 +234,12 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 		if divideCount <= 0 {
 			divideCount = defaultAxisDivideCount
 		}
+		divideCount = p.getTerminalDivideCount(rangeHeight, divideCount)
 		max, min := opt.SeriesList.GetMaxMin(index)
+		if opt.valueFromZero {
+			max = math.Max(max, 0)
+			min = math.Min(min, 0)
+		}
 		r := NewRange(AxisRangeOption{
 			Painter: p,
 			Min:     min,
@@ -290,10 +339,11 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	if opt.Parent == nil {
 		isChild = false
 		p, err := NewPainter(PainterOptions{
-			Type:   opt.Type,
-			Width:  opt.Width,
-			Height: opt.Height,
-			Font:   opt.font,
+			Type:             opt.Type,
+			Width:            opt.Width,
+			Height:           opt.Height,
+			Font:             opt.font,
+			DevicePixelRatio: opt.PixelRatio,
 		})
 		if err != nil {
 			return nil, err
@@ -307,11 +357,26 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	if !opt.Box.IsZero() {
 		p = p.Child(PainterBoxOption(opt.Box))
 	}
-	if !isChild {
-		p.SetBackground(p.Width(), p.Height(), opt.BackgroundColor)
-	}
 	seriesList := opt.SeriesList
 	seriesList.init()
+	if !isChild {
+		p.setAccessible(opt.Accessible, accessibleContent{
+			title:       opt.Title.Text,
+			description: opt.Title.Subtext,
+			xAxisData:   opt.XAxis.Data,
+			seriesList:  seriesList,
+		})
+		p.setSVGOption(opt.SVG, opt.theme, len(seriesList))
+		p.setHTMLTitle(opt.Title.Text)
+		p.setTerminalOption(opt.Terminal)
+		p.backdrop = opt.BackdropColor
+		// 透明背景不绘制背景色
+		if !opt.TransparentBackground {
+			p.startComponent("background")
+			p.SetBackground(p.Width(), p.Height(), opt.BackgroundColor)
+			p.endComponent()
+		}
+	}
 
 	seriesCount := len(seriesList)
 
@@ -322,6 +387,9 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	pieSeriesList := seriesList.Filter(ChartTypePie)
 	radarSeriesList := seriesList.Filter(ChartTypeRadar)
 	funnelSeriesList := seriesList.Filter(ChartTypeFunnel)
+	polarSeriesList := seriesList.Filter(ChartTypePolarBar)
+	polarSeriesList = append(polarSeriesList, seriesList.Filter(ChartTypePolarLine)...)
+	parallelSeriesList := seriesList.Filter(ChartTypeParallel)
 
 	if len(horizontalBarSeriesList) != 0 && len(horizontalBarSeriesList) != seriesCount {
 		return nil, errors.New("Horizontal bar can not mix other charts")
@@ -335,6 +403,32 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	if len(funnelSeriesList) != 0 && len(funnelSeriesList) != seriesCount {
 		return nil, errors.New("Funnel can not mix other charts")
 	}
+	if len(polarSeriesList) != 0 && len(polarSeriesList) != seriesCount {
+		return nil, errors.New("Polar can not mix other charts")
+	}
+	if len(parallelSeriesList) != 0 && len(parallelSeriesList) != seriesCount {
+		return nil, errors.New("Parallel can not mix other charts")
+	}
+	isSunburst := len(opt.Sunburst.Data) != 0
+	if isSunburst && seriesCount != 0 {
+		return nil, errors.New("Sunburst can not mix other charts")
+	}
+	isCalendar := len(opt.Calendar.Data) != 0
+	if isCalendar && (seriesCount != 0 || isSunburst) {
+		return nil, errors.New("Calendar can not mix other charts")
+	}
+	isGantt := len(opt.Gantt.Data) != 0
+	if isGantt && (seriesCount != 0 || isSunburst || isCalendar) {
+		return nil, errors.New("Gantt can not mix other charts")
+	}
+	isGraph := len(opt.Graph.Nodes) != 0
+	if isGraph && (seriesCount != 0 || isSunburst || isCalendar || isGantt) {
+		return nil, errors.New("Graph can not mix other charts")
+	}
+	isBullet := len(opt.Bullet.Data) != 0
+	if isBullet && (seriesCount != 0 || isSunburst || isCalendar || isGantt || isGraph) {
+		return nil, errors.New("Bullet can not mix other charts")
+	}
 
 	axisReversed := len(horizontalBarSeriesList) != 0
 	renderOpt := defaultRenderOption{
@@ -346,12 +440,19 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		TitleOption:  opt.Title,
 		LegendOption: opt.Legend,
 		axisReversed: axisReversed,
+		sparkline:    opt.Sparkline.Enabled,
 		// 前置已设置背景色
 		backgroundIsFilled: true,
 	}
 	if len(pieSeriesList) != 0 ||
 		len(radarSeriesList) != 0 ||
-		len(funnelSeriesList) != 0 {
+		len(funnelSeriesList) != 0 ||
+		len(polarSeriesList) != 0 ||
+		len(parallelSeriesList) != 0 ||
+		isSunburst ||
+		isCalendar ||
+		isGantt ||
+		isGraph {
 		renderOpt.XAxis.Show = FalseFlag()
 		renderOpt.YAxisOptions = []YAxisOption{
 			{
@@ -363,6 +464,9 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		renderOpt.YAxisOptions[0].DivideCount = len(renderOpt.YAxisOptions[0].Data)
 		renderOpt.YAxisOptions[0].Unit = 1
 	}
+	if isBullet {
+		fillBulletRenderOption(&renderOpt, opt.Bullet)
+	}
 
 	renderResult, err := defaultRender(p, renderOpt)
 	if err != nil {
@@ -421,6 +525,7 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 				StrokeWidth: opt.LineStrokeWidth,
 				FillArea:    opt.FillArea,
 				Opacity:     opt.Opacity,
+				Sparkline:   opt.Sparkline,
 			}).render(renderResult, lineSeriesList)
 			return err
 		})
@@ -450,6 +555,99 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		})
 	}
 
+	// polar chart
+	if len(polarSeriesList) != 0 {
+		handler.Add(func() error {
+			polar := opt.Polar
+			if len(polar.Data) == 0 {
+				polar.Data = opt.XAxis.Data
+			}
+			_, err := NewPolarChart(p, PolarChartOption{
+				Theme:       opt.theme,
+				Font:        opt.font,
+				Polar:       polar,
+				SymbolShow:  opt.SymbolShow,
+				StrokeWidth: opt.LineStrokeWidth,
+				FillArea:    opt.FillArea,
+				Opacity:     opt.Opacity,
+			}).render(renderResult, polarSeriesList)
+			return err
+		})
+	}
+
+	// parallel chart
+	if len(parallelSeriesList) != 0 {
+		handler.Add(func() error {
+			parallel := opt.Parallel
+			if parallel.StrokeWidth <= 0 {
+				parallel.StrokeWidth = opt.LineStrokeWidth
+			}
+			_, err := NewParallelChart(p, ParallelChartOption{
+				Theme:    opt.theme,
+				Font:     opt.font,
+				Parallel: parallel,
+			}).render(renderResult, parallelSeriesList)
+			return err
+		})
+	}
+
+	// sunburst chart
+	if isSunburst {
+		handler.Add(func() error {
+			_, err := NewSunburstChart(p, SunburstChartOption{
+				Theme:    opt.theme,
+				Font:     opt.font,
+				Sunburst: opt.Sunburst,
+			}).render(renderResult, opt.Sunburst.Data)
+			return err
+		})
+	}
+
+	// calendar chart
+	if isCalendar {
+		handler.Add(func() error {
+			_, err := NewCalendarChart(p, CalendarChartOption{
+				Theme: opt.theme,
+				Font:  opt.font,
+			}).render(renderResult, opt.Calendar)
+			return err
+		})
+	}
+
+	// gantt chart
+	if isGantt {
+		handler.Add(func() error {
+			_, err := NewGanttChart(p, GanttChartOption{
+				Theme:  opt.theme,
+				Font:   opt.font,
+				Legend: opt.Legend,
+			}).render(renderResult, opt.Gantt)
+			return err
+		})
+	}
+
+	// graph chart
+	if isGraph {
+		handler.Add(func() error {
+			_, err := NewGraphChart(p, GraphChartOption{
+				Theme: opt.theme,
+				Font:  opt.font,
+			}).render(renderResult, opt.Graph)
+			return err
+		})
+	}
+
+	// bullet chart
+	if isBullet {
+		handler.Add(func() error {
+			_, err := NewBulletChart(p, BulletChartOption{
+				Theme: opt.theme,
+				Font:  opt.font,
+			}).render(renderResult, opt.Bullet)
+			return err
+		})
+	}
+
 	err = handler.Do()
 
 	if err != nil {
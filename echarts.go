@@ -58,6 +58,87 @@ func (p *EChartsPosition) UnmarshalJSON(data []byte) error {
 
 type EChartStyle struct {
 	Color string `json:"color"`
+	// The gradient of color object, the color is the first color of gradient
+	Gradient *Gradient `json:"-"`
+}
+
+type EChartsGradientColorStop struct {
+	Offset float64 `json:"offset"`
+	Color  string  `json:"color"`
+}
+
+// EChartsGradient is the gradient color object of echarts,
+// the coordinates are relative to the bounding box of shape
+type EChartsGradient struct {
+	Type       string                     `json:"type"`
+	X          *float64                   `json:"x"`
+	Y          *float64                   `json:"y"`
+	X2         *float64                   `json:"x2"`
+	Y2         *float64                   `json:"y2"`
+	R          *float64                   `json:"r"`
+	ColorStops []EChartsGradientColorStop `json:"colorStops"`
+}
+
+// ToGradient converts the echarts gradient to gradient
+func (eg *EChartsGradient) ToGradient() *Gradient {
+	getValue := func(value *float64, defaultValue float64) float64 {
+		if value == nil {
+			return defaultValue
+		}
+		return *value
+	}
+	stops := make([]GradientStop, len(eg.ColorStops))
+	for index, item := range eg.ColorStops {
+		stops[index] = GradientStop{
+			Offset: item.Offset,
+			Color:  parseColor(item.Color),
+		}
+	}
+	if eg.Type == GradientTypeRadial {
+		return NewRadialGradient(
+			getValue(eg.X, 0.5),
+			getValue(eg.Y, 0.5),
+			getValue(eg.R, 0.5),
+			stops...,
+		)
+	}
+	return &Gradient{
+		Type:  GradientTypeLinear,
+		X:     getValue(eg.X, 0),
+		Y:     getValue(eg.Y, 0),
+		X2:    getValue(eg.X2, 1),
+		Y2:    getValue(eg.Y2, 0),
+		Stops: stops,
+	}
+}
+
+func (es *EChartStyle) UnmarshalJSON(data []byte) error {
+	v := struct {
+		Color json.RawMessage `json:"color"`
+	}{}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	color := bytes.TrimSpace(v.Color)
+	if len(color) == 0 || bytes.Equal(color, []byte("null")) {
+		return nil
+	}
+	// 渐变色对象
+	if color[0] == '{' {
+		g := EChartsGradient{}
+		err = json.Unmarshal(color, &g)
+		if err != nil {
+			return err
+		}
+		if len(g.ColorStops) == 0 {
+			return nil
+		}
+		es.Gradient = g.ToGradient()
+		es.Color = g.ColorStops[0].Color
+		return nil
+	}
+	return json.Unmarshal(color, &es.Color)
 }
 
 func (es *EChartStyle) ToStyle() Style {
@@ -93,6 +174,8 @@ type EChartsSeriesData struct {
 	Value     EChartsSeriesDataValue `json:"value"`
 	Name      string                 `json:"name"`
 	ItemStyle EChartStyle            `json:"itemStyle"`
+	// The children of sunburst data
+	Children []EChartsSeriesData `json:"children"`
 }
 type _EChartsSeriesData EChartsSeriesData
 
@@ -123,9 +206,26 @@ func (es *EChartsSeriesData) UnmarshalJSON(data []byte) error {
 	es.Name = v.Name
 	es.Value = v.Value
 	es.ItemStyle = v.ItemStyle
+	es.Children = v.Children
 	return nil
 }
 
+// ToSunburstData converts the series data to sunburst data
+func (es *EChartsSeriesData) ToSunburstData() SunburstData {
+	data := SunburstData{
+		Name:  es.Name,
+		Value: es.Value.First(),
+		Color: parseColor(es.ItemStyle.Color),
+	}
+	if len(es.Children) != 0 {
+		data.Children = make([]SunburstData, len(es.Children))
+		for index := range es.Children {
+			data.Children[index] = es.Children[index].ToSunburstData()
+		}
+	}
+	return data
+}
+
 type EChartsXAxisData struct {
 	BoundaryGap *bool    `json:"boundaryGap"`
 	SplitNumber int      `json:"splitNumber"`
@@ -303,6 +403,8 @@ type EChartsSeries struct {
 	Radius     string              `json:"radius"`
 	YAxisIndex int                 `json:"yAxisIndex"`
 	ItemStyle  EChartStyle         `json:"itemStyle"`
+	// 区域填充，仅用于line
+	AreaStyle *EChartStyle `json:"areaStyle"`
 	// label的配置
 	Label     EChartsLabelOption `json:"label"`
 	MarkPoint EChartsMarkPoint   `json:"markPoint"`
@@ -315,12 +417,21 @@ type EChartsSeriesList []EChartsSeries
 func (esList EChartsSeriesList) ToSeriesList() SeriesList {
 	seriesList := make(SeriesList, 0, len(esList))
 	for _, item := range esList {
+		// sunburst的数据为树形结构，不转换为series
+		if item.Type == ChartTypeSunburst {
+			continue
+		}
 		// 如果是pie，则每个子荐生成一个series
 		if item.Type == ChartTypePie {
 			for _, dataItem := range item.Data {
+				fillGradient := item.ItemStyle.Gradient
+				if dataItem.ItemStyle.Gradient != nil {
+					fillGradient = dataItem.ItemStyle.Gradient
+				}
 				seriesList = append(seriesList, Series{
-					Type: item.Type,
-					Name: dataItem.Name,
+					Type:         item.Type,
+					FillGradient: fillGradient,
+					Name:         dataItem.Name,
 					Label: SeriesLabel{
 						Show: true,
 					},
@@ -356,15 +467,22 @@ func (esList EChartsSeriesList) ToSeriesList() SeriesList {
 		data := make([]SeriesData, len(item.Data))
 		for j, dataItem := range item.Data {
 			data[j] = SeriesData{
-				Value: dataItem.Value.First(),
-				Style: dataItem.ItemStyle.ToStyle(),
+				Value:        dataItem.Value.First(),
+				Style:        dataItem.ItemStyle.ToStyle(),
+				FillGradient: dataItem.ItemStyle.Gradient,
 			}
 		}
+		fillGradient := item.ItemStyle.Gradient
+		if item.AreaStyle != nil && item.AreaStyle.Gradient != nil {
+			fillGradient = item.AreaStyle.Gradient
+		}
 		seriesList = append(seriesList, Series{
-			Type:      item.Type,
-			Data:      data,
-			AxisIndex: item.YAxisIndex,
-			Style:     item.ItemStyle.ToStyle(),
+			Type:         item.Type,
+			FillGradient: fillGradient,
+			FillArea:     item.AreaStyle != nil,
+			Data:         data,
+			AxisIndex:    item.YAxisIndex,
+			Style:        item.ItemStyle.ToStyle(),
 			Label: SeriesLabel{
 				Color:    parseColor(item.Label.Color),
 				Show:     item.Label.Show,
@@ -419,6 +537,17 @@ type EChartsOption struct {
 	} `json:"radar"`
 	Series   EChartsSeriesList `json:"series"`
 	Children []EChartsOption   `json:"children"`
+	Aria     struct {
+		Enabled bool `json:"enabled"`
+		Label   struct {
+			Description string `json:"description"`
+		} `json:"label"`
+		// 非echarts参数，是否添加隐藏的数据表格
+		DataTable bool `json:"dataTable"`
+	} `json:"aria"`
+	BackgroundColor string `json:"backgroundColor"`
+	// 非echarts参数，透明背景时假定的背景色
+	BackdropColor string `json:"backdropColor"`
 }
 
 func (eo *EChartsOption) ToOption() ChartOption {
@@ -459,6 +588,26 @@ func (eo *EChartsOption) ToOption() ChartOption {
 		Padding:         eo.Padding.Box,
 		Box:             eo.Box,
 		SeriesList:      eo.Series.ToSeriesList(),
+		Accessible: AccessibleOption{
+			Enabled:     eo.Aria.Enabled,
+			Description: eo.Aria.Label.Description,
+			DataTable:   eo.Aria.DataTable,
+		},
+		BackdropColor: parseColor(eo.BackdropColor),
+	}
+	if eo.BackgroundColor == "transparent" {
+		o.TransparentBackground = true
+	} else {
+		o.BackgroundColor = parseColor(eo.BackgroundColor)
+	}
+	for _, item := range eo.Series {
+		if item.Type != ChartTypeSunburst {
+			continue
+		}
+		o.Sunburst.Radius = item.Radius
+		for index := range item.Data {
+			o.Sunburst.Data = append(o.Sunburst.Data, item.Data[index].ToSunburstData())
+		}
 	}
 	isHorizontalChart := false
 	for _, item := range eo.XAxis.Data {
@@ -526,3 +675,19 @@ func RenderEChartsToPNG(options string) ([]byte, error) {
 func RenderEChartsToSVG(options string) ([]byte, error) {
 	return renderEcharts(options, "svg")
 }
+
+func RenderEChartsToPDF(options string) ([]byte, error) {
+	return renderEcharts(options, "pdf")
+}
+
+func RenderEChartsToJPEG(options string) ([]byte, error) {
+	return renderEcharts(options, "jpeg")
+}
+
+func RenderEChartsToGIF(options string) ([]byte, error) {
+	return renderEcharts(options, "gif")
+}
+
+func RenderEChartsToHTML(options string) ([]byte, error) {
+	return renderEcharts(options, "html")
+}
@@ -90,6 +90,28 @@ func TestEChartsSeriesData(t *testing.T) {
 	}, es)
 }
 
+func TestEChartsSeriesDataToSunburstData(t *testing.T) {
+	assert := assert.New(t)
+	es := EChartsSeriesData{}
+	err := es.UnmarshalJSON([]byte(`{"name":"Infra","children":[{"name":"Compute","value":30},{"name":"Storage","value":20,"itemStyle":{"color":"#a90000"}}]}`))
+	assert.Nil(err)
+	assert.Equal(SunburstData{
+		Name: "Infra",
+		Children: []SunburstData{
+			{
+				Name:  "Compute",
+				Value: 30,
+			},
+			{
+				Name:  "Storage",
+				Value: 20,
+				Color: parseColor("#a90000"),
+			},
+		},
+	}, es.ToSunburstData())
+	assert.Equal(50.0, es.ToSunburstData().Sum())
+}
+
 func TestEChartsXAxis(t *testing.T) {
 	assert := assert.New(t)
 	ex := EChartsXAxis{}
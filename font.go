@@ -31,6 +31,9 @@ import (
 )
 
 var fonts = sync.Map{}
+
+// The data of installed fonts, it is used for embedding font
+var fontDatas = sync.Map{}
 var ErrFontNotExists = errors.New("font is not exists")
 var defaultFontFamily = "defaultFontFamily"
 
@@ -48,9 +51,20 @@ func InstallFont(fontFamily string, data []byte) error {
 		return err
 	}
 	fonts.Store(fontFamily, font)
+	fontDatas.Store(font, data)
 	return nil
 }
 
+// getFontData returns the data of installed font
+func getFontData(font *truetype.Font) []byte {
+	value, ok := fontDatas.Load(font)
+	if !ok {
+		return nil
+	}
+	data, _ := value.([]byte)
+	return data
+}
+
 // GetDefaultFont get default font
 func GetDefaultFont() (*truetype.Font, error) {
 	return GetFont(defaultFontFamily)
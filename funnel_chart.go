@@ -145,6 +145,7 @@ func (f *funnelChart) render(result *defaultRenderResult, seriesList SeriesList)
 		}
 		color := theme.GetSeriesColor(series.index)
 
+		seriesPainter.startSeriesGroup(series)
 		seriesPainter.OverrideDrawingStyle(Style{
 			FillColor: color,
 		}).FillArea(points)
@@ -160,6 +161,7 @@ func (f *funnelChart) render(result *defaultRenderResult, seriesList SeriesList)
 		textX := width>>1 - textBox.Width()>>1
 		textY := y + h>>1
 		seriesPainter.Text(text, textX, textY)
+		seriesPainter.endGroup()
 		y += (h + gap)
 	}
 
@@ -0,0 +1,362 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type ganttChart struct {
+	p   *Painter
+	opt *GanttChartOption
+}
+
+type GanttItem struct {
+	// The category of item, items with the same category are shown in the same row
+	Category string
+	// The name of item, it is shown in the bar if there is enough space
+	Name string
+	// The start time of item
+	Start time.Time
+	// The end time of item
+	End time.Time
+	// The status of item, items with the same status have the same color
+	Status string
+	// The color of item, it overrides the color of status
+	Color Color
+}
+
+type GanttOption struct {
+	// The data of gantt
+	Data []GanttItem
+	// The categories(rows) of gantt, the categories of data will be used if it is nil
+	Categories []string
+	// The status list, the color of status is the series color of its index.
+	// The legend data will be used if it is nil
+	Statuses []string
+	// The color of status, it overrides the series color
+	StatusColors map[string]Color
+	// The start time of time axis, the min start time of data will be used if it is zero
+	Start time.Time
+	// The end time of time axis, the max end time of data will be used if it is zero
+	End time.Time
+	// The layout of time label, it is chosen by the interval of ticks if it is empty
+	TimeFormat string
+	// The height of bar
+	BarHeight int
+	// The round radius of bar
+	RoundRadius int
+	// The flag for show name of item, set this to *false will hide it
+	LabelShow *bool
+}
+
+type GanttChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The padding of gantt chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The gantt option
+	Gantt GanttOption
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewGanttChart returns a gantt chart renderer
+func NewGanttChart(p *Painter, opt GanttChartOption) *ganttChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &ganttChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// getCategories returns the categories of gantt
+func (opt *GanttOption) getCategories() []string {
+	if len(opt.Categories) != 0 {
+		return opt.Categories
+	}
+	categories := make([]string, 0)
+	for _, item := range opt.Data {
+		if !containsString(categories, item.Category) {
+			categories = append(categories, item.Category)
+		}
+	}
+	return categories
+}
+
+// getStatusColor returns the color of status
+func (opt *GanttOption) getStatusColor(theme ColorPalette, statuses []string, status string) Color {
+	if color, ok := opt.StatusColors[status]; ok {
+		return color
+	}
+	for index, value := range statuses {
+		if value == status {
+			return theme.GetSeriesColor(index)
+		}
+	}
+	return theme.GetSeriesColor(0)
+}
+
+func (g *ganttChart) render(result *defaultRenderResult, opt GanttOption) (Box, error) {
+	if len(opt.Data) == 0 {
+		return BoxZero, errors.New("The data of gantt chart can not be nil")
+	}
+	theme := g.opt.Theme
+	seriesPainter := result.seriesPainter
+
+	categories := opt.getCategories()
+	statuses := append([]string{}, opt.Statuses...)
+	if len(statuses) == 0 {
+		statuses = append(statuses, g.opt.Legend.Data...)
+	}
+	for _, item := range opt.Data {
+		if !containsString(statuses, item.Status) {
+			statuses = append(statuses, item.Status)
+		}
+	}
+	start := opt.Start
+	end := opt.End
+	for _, item := range opt.Data {
+		if item.End.Before(item.Start) {
+			return BoxZero, errors.New("The end time of gantt item should be after start time")
+		}
+		if opt.Start.IsZero() && (start.IsZero() || item.Start.Before(start)) {
+			start = item.Start
+		}
+		if opt.End.IsZero() && (end.IsZero() || item.End.After(end)) {
+			end = item.End
+		}
+	}
+
+	fontSize := theme.GetFontSize()
+	fontColor := theme.GetTextColor()
+	seriesPainter.OverrideTextStyle(Style{
+		FontColor: fontColor,
+		FontSize:  fontSize,
+		Font:      g.opt.Font,
+	})
+	labelMargin := 10
+	categoryWidth, _ := seriesPainter.MeasureTextMaxWidthHeight(categories)
+	categoryWidth += labelMargin
+	width := seriesPainter.Width() - categoryWidth
+	height := seriesPainter.Height()
+	if width <= 0 || height <= 0 {
+		return BoxZero, errors.New("The size of gantt chart is too small")
+	}
+	ticks := newTimeAxisTicks(seriesPainter, start, end, width, opt.TimeFormat)
+	// 首尾的标签居中展示，因此需预留一半的宽度
+	firstLabelWidth := seriesPainter.MeasureText(ticks.Labels[0]).Width()
+	lastLabelWidth := seriesPainter.MeasureText(ticks.Labels[len(ticks.Labels)-1]).Width()
+	if categoryWidth < firstLabelWidth>>1 {
+		width -= firstLabelWidth>>1 - categoryWidth
+		categoryWidth = firstLabelWidth >> 1
+	}
+	width -= lastLabelWidth >> 1
+	if width <= 0 {
+		return BoxZero, errors.New("The size of gantt chart is too small")
+	}
+	ticks = newTimeAxisTicks(seriesPainter, start, end, width, opt.TimeFormat)
+
+	// 分类
+	rowValues := autoDivide(height, len(categories))
+	for index, category := range categories {
+		b := seriesPainter.MeasureText(category)
+		y := (rowValues[index] + rowValues[index+1] + b.Height()) >> 1
+		seriesPainter.Text(category, categoryWidth-labelMargin-b.Width(), y)
+	}
+
+	plotPainter := seriesPainter.Child(PainterPaddingOption(Box{
+		Left: categoryWidth,
+	}))
+	// 时间轴的辅助线
+	plotPainter.OverrideDrawingStyle(Style{
+		StrokeColor: theme.GetAxisSplitLineColor(),
+		StrokeWidth: 1,
+	})
+	tickValues := make([]int, len(ticks.Values))
+	for index, value := range ticks.Values {
+		x := ticks.GetX(value, width)
+		tickValues[index] = x
+		if index == 0 {
+			continue
+		}
+		plotPainter.LineStroke([]Point{
+			{
+				X: x,
+				Y: 0,
+			},
+			{
+				X: x,
+				Y: height,
+			},
+		})
+	}
+	// 坐标轴
+	tickLength := 5
+	plotPainter.OverrideDrawingStyle(Style{
+		StrokeColor: theme.GetAxisStrokeColor(),
+		StrokeWidth: 1,
+	})
+	plotPainter.LineStroke([]Point{
+		{
+			X: 0,
+			Y: 0,
+		},
+		{
+			X: 0,
+			Y: height,
+		},
+		{
+			X: width,
+			Y: height,
+		},
+	})
+	for _, y := range rowValues {
+		plotPainter.LineStroke([]Point{
+			{
+				X: -tickLength,
+				Y: y,
+			},
+			{
+				X: 0,
+				Y: y,
+			},
+		})
+	}
+	for index, x := range tickValues {
+		plotPainter.LineStroke([]Point{
+			{
+				X: x,
+				Y: height,
+			},
+			{
+				X: x,
+				Y: height + tickLength,
+			},
+		})
+		text := ticks.Labels[index]
+		b := plotPainter.MeasureText(text)
+		plotPainter.Text(text, x-b.Width()>>1, height+tickLength+labelMargin+b.Height()>>1)
+	}
+
+	// 时间条
+	rowHeight := height / len(categories)
+	barHeight := rowHeight * 6 / 10
+	if opt.BarHeight > 0 && opt.BarHeight < rowHeight {
+		barHeight = opt.BarHeight
+	}
+	barHeight = chart.MaxInt(barHeight, 1)
+	labelPadding := 5
+	for _, item := range opt.Data {
+		row := -1
+		for index, category := range categories {
+			if category == item.Category {
+				row = index
+				break
+			}
+		}
+		if row == -1 {
+			continue
+		}
+		left := ticks.GetX(item.Start, width)
+		right := ticks.GetX(item.End, width)
+		if right <= left {
+			right = left + 1
+		}
+		top := (rowValues[row] + rowValues[row+1] - barHeight) >> 1
+		box := Box{
+			Top:    top,
+			Left:   left,
+			Right:  right,
+			Bottom: top + barHeight,
+		}
+		fillColor := item.Color
+		if fillColor.IsZero() {
+			fillColor = opt.getStatusColor(theme, statuses, item.Status)
+		}
+		plotPainter.OverrideDrawingStyle(Style{
+			FillColor: fillColor,
+		})
+		if opt.RoundRadius <= 0 || box.Width() < 2*opt.RoundRadius {
+			plotPainter.Rect(box)
+		} else {
+			plotPainter.RoundedRect(box, opt.RoundRadius)
+		}
+		if item.Name == "" || isFalse(opt.LabelShow) {
+			continue
+		}
+		labelFontColor := defaultDarkFontColor
+		if plotPainter.isLightColor(fillColor) {
+			labelFontColor = defaultLightFontColor
+		}
+		plotPainter.OverrideTextStyle(Style{
+			FontColor: labelFontColor,
+			FontSize:  labelFontSize,
+			Font:      g.opt.Font,
+		})
+		b := plotPainter.MeasureText(item.Name)
+		// 空间不足则不展示
+		if b.Width()+2*labelPadding > box.Width() || b.Height() > barHeight {
+			continue
+		}
+		plotPainter.Text(item.Name, left+labelPadding, top+(barHeight+b.Height())>>1)
+	}
+
+	return g.p.box, nil
+}
+
+func (g *ganttChart) Render() (Box, error) {
+	p := g.p
+	opt := g.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:   opt.Theme,
+		Padding: opt.Padding,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption:        opt.Title,
+		LegendOption:       opt.Legend,
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return g.render(renderResult, opt.Gantt)
+}
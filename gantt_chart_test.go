@@ -0,0 +1,167 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGanttChart(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2022, 6, 1, 22, 10, 0, 0, time.UTC)
+	minutes := func(value int) time.Time {
+		return start.Add(time.Duration(value) * time.Minute)
+	}
+	data := []GanttItem{
+		{
+			Category: "build",
+			Name:     "compile",
+			Start:    minutes(0),
+			End:      minutes(25),
+			Status:   "success",
+		},
+		{
+			Category: "test",
+			Name:     "unit test",
+			Start:    minutes(25),
+			End:      minutes(70),
+			Status:   "failed",
+		},
+		{
+			Category: "test",
+			Name:     "retry",
+			Start:    minutes(75),
+			End:      minutes(110),
+			Status:   "success",
+		},
+		{
+			Category: "deploy",
+			Name:     "canary",
+			Start:    minutes(110),
+			End:      minutes(150),
+			Status:   "running",
+		},
+	}
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewGanttChart(p, GanttChartOption{
+					Title: TitleOption{
+						Text: "Pipeline",
+					},
+					Legend: NewLegendOption([]string{
+						"success",
+						"failed",
+						"running",
+					}, PositionRight),
+					Gantt: GanttOption{
+						Data: data,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 317 9\nL 347 9\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"332\" cy=\"9\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"349\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">success</text><path  d=\"M 426 9\nL 456 9\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"441\" cy=\"9\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"458\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">failed</text><path  d=\"M 517 9\nL 547 9\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><circle cx=\"532\" cy=\"9\" r=\"5\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"549\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">running</text><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Pipeline</text><text x=\"12\" y=\"98\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">build</text><text x=\"19\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">test</text><text x=\"0\" y=\"321\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">deploy</text><path  d=\"M 143 35\nL 143 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 231 35\nL 231 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 318 35\nL 318 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 406 35\nL 406 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 493 35\nL 493 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 581 35\nL 581 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 56 35\nL 56 370\nL 581 370\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 35\nL 56 35\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 146\nL 56 146\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 258\nL 56 258\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 370\nL 56 370\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 56 370\nL 56 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"37\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">22:00</text><path  d=\"M 143 370\nL 143 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"124\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">22:30</text><path  d=\"M 231 370\nL 231 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"212\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">23:00</text><path  d=\"M 318 370\nL 318 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"299\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">23:30</text><path  d=\"M 406 370\nL 406 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"386\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">06-02</text><path  d=\"M 493 370\nL 493 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"474\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">00:30</text><path  d=\"M 581 370\nL 581 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"562\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">01:00</text><path  d=\"M 85 57\nL 158 57\nL 158 123\nL 85 123\nL 85 57\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"90\" y=\"96\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">compile</text><path  d=\"M 158 169\nL 289 169\nL 289 235\nL 158 235\nL 158 169\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"163\" y=\"208\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">unit test</text><path  d=\"M 303 169\nL 406 169\nL 406 235\nL 303 235\nL 303 169\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"308\" y=\"208\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">retry</text><path  d=\"M 406 281\nL 522 281\nL 522 347\nL 406 347\nL 406 281\" style=\"stroke-width:0;stroke:none;fill:rgba(250,200,88,1.0)\"/><text x=\"411\" y=\"320\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">canary</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewGanttChart(p, GanttChartOption{
+					Gantt: GanttOption{
+						Data:       data,
+						Categories: []string{"deploy", "test", "build"},
+						StatusColors: map[string]Color{
+							"failed": {
+								R: 238,
+								G: 102,
+								B: 102,
+								A: 255,
+							},
+						},
+						TimeFormat:  "15:04",
+						BarHeight:   20,
+						RoundRadius: 4,
+						LabelShow:   FalseFlag(),
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"0\" y=\"69\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">deploy</text><text x=\"19\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">test</text><text x=\"12\" y=\"315\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">build</text><path  d=\"M 143 0\nL 143 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 231 0\nL 231 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 318 0\nL 318 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 406 0\nL 406 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 493 0\nL 493 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 581 0\nL 581 370\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 56 0\nL 56 370\nL 581 370\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 0\nL 56 0\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 123\nL 56 123\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 246\nL 56 246\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 370\nL 56 370\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 56 370\nL 56 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"37\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">22:00</text><path  d=\"M 143 370\nL 143 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"124\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">22:30</text><path  d=\"M 231 370\nL 231 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"212\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">23:00</text><path  d=\"M 318 370\nL 318 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"299\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">23:30</text><path  d=\"M 406 370\nL 406 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"387\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">00:00</text><path  d=\"M 493 370\nL 493 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"474\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">00:30</text><path  d=\"M 581 370\nL 581 375\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"562\" y=\"392\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">01:00</text><path  d=\"M 89 298\nL 154 298\nL 154 298\nA 4 4 90.00 0 1 158 302\nL 158 314\nL 158 314\nA 4 4 90.00 0 1 154 318\nL 89 318\nL 89 318\nA 4 4 90.00 0 1 85 314\nL 85 302\nL 85 302\nA 4 4 90.00 0 1 89 298\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 162 174\nL 285 174\nL 285 174\nA 4 4 90.00 0 1 289 178\nL 289 190\nL 289 190\nA 4 4 90.00 0 1 285 194\nL 162 194\nL 162 194\nA 4 4 90.00 0 1 158 190\nL 158 178\nL 158 178\nA 4 4 90.00 0 1 162 174\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(238,102,102,1.0)\"/><path  d=\"\" style=\"stroke-width:0;stroke:none;fill:rgba(238,102,102,1.0)\"/><path  d=\"M 307 174\nL 402 174\nL 402 174\nA 4 4 90.00 0 1 406 178\nL 406 190\nL 406 190\nA 4 4 90.00 0 1 402 194\nL 307 194\nL 307 194\nA 4 4 90.00 0 1 303 190\nL 303 178\nL 303 178\nA 4 4 90.00 0 1 307 174\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 410 51\nL 518 51\nL 518 51\nA 4 4 90.00 0 1 522 55\nL 522 67\nL 522 67\nA 4 4 90.00 0 1 518 71\nL 410 71\nL 410 71\nA 4 4 90.00 0 1 406 67\nL 406 55\nL 406 55\nA 4 4 90.00 0 1 410 51\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(250,200,88,1.0)\"/><path  d=\"\" style=\"stroke-width:0;stroke:none;fill:rgba(250,200,88,1.0)\"/></svg>",
+		},
+	}
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
+
+func TestGanttChartFirstLabel(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2022, 6, 1, 22, 10, 0, 0, time.UTC)
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  600,
+		Height: 400,
+	}, PainterThemeOption(defaultTheme))
+	assert.Nil(err)
+	_, err = NewGanttChart(p, GanttChartOption{
+		Gantt: GanttOption{
+			Data: []GanttItem{
+				{
+					Category: "a",
+					Name:     "compile",
+					Start:    start,
+					End:      start.Add(time.Hour),
+				},
+			},
+			TimeFormat: "2006-01-02 15:04",
+			LabelShow:  FalseFlag(),
+		},
+	}).Render()
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	// 首个标签不超出画布
+	assert.Contains(string(data), `<text x="0" y="392"`)
+	assert.NotContains(string(data), `<text x="-`)
+}
@@ -7,11 +7,11 @@ require (
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0
 	github.com/stretchr/testify v1.8.2
 	github.com/wcharczuk/go-chart/v2 v2.1.0
+	golang.org/x/image v0.0.0-20200927104501-e162460cd6b5
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/image v0.0.0-20200927104501-e162460cd6b5 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
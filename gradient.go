@@ -0,0 +1,290 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	GradientTypeLinear = "linear"
+	GradientTypeRadial = "radial"
+)
+
+type GradientStop struct {
+	// The offset of stop, it should be 0 to 1
+	Offset float64
+	// The color of stop
+	Color Color
+}
+
+// Gradient is the gradient fill of shape, the coordinates are relative
+// to the bounding box of shape, (0, 0) is the top left and (1, 1) is the bottom right
+type Gradient struct {
+	// The type of gradient, "linear" or "radial", default is "linear"
+	Type string
+	// The start point of linear gradient, or the center of radial gradient
+	X float64
+	Y float64
+	// The end point of linear gradient
+	X2 float64
+	Y2 float64
+	// The radius of radial gradient
+	R float64
+	// The color stops of gradient
+	Stops []GradientStop
+}
+
+// NewLinearGradient returns a linear gradient of angle(degree),
+// 0 is from left to right and 90 is from top to bottom
+func NewLinearGradient(angle float64, stops ...GradientStop) *Gradient {
+	radians := angle * math.Pi / 180
+	dx := math.Cos(radians)
+	dy := math.Sin(radians)
+	// 保证渐变覆盖整个区域
+	half := (math.Abs(dx) + math.Abs(dy)) / 2
+	round := func(v float64) float64 {
+		return math.Round(v*1e6) / 1e6
+	}
+	return &Gradient{
+		Type:  GradientTypeLinear,
+		X:     round(0.5 - dx*half),
+		Y:     round(0.5 - dy*half),
+		X2:    round(0.5 + dx*half),
+		Y2:    round(0.5 + dy*half),
+		Stops: stops,
+	}
+}
+
+// NewRadialGradient returns a radial gradient of center and radius
+func NewRadialGradient(x, y, r float64, stops ...GradientStop) *Gradient {
+	return &Gradient{
+		Type:  GradientTypeRadial,
+		X:     x,
+		Y:     y,
+		R:     r,
+		Stops: stops,
+	}
+}
+
+// IsRadial checks the gradient is radial
+func (g *Gradient) IsRadial() bool {
+	return g.Type == GradientTypeRadial
+}
+
+// getStops returns the sorted stops, which starts with 0 and ends with 1
+func (g *Gradient) getStops() []GradientStop {
+	stops := make([]GradientStop, 0, len(g.Stops)+2)
+	for _, stop := range g.Stops {
+		stop.Offset = math.Max(0, math.Min(1, stop.Offset))
+		stops = append(stops, stop)
+	}
+	if len(stops) == 0 {
+		return stops
+	}
+	sort.SliceStable(stops, func(i, j int) bool {
+		return stops[i].Offset < stops[j].Offset
+	})
+	if stops[0].Offset > 0 {
+		stops = append([]GradientStop{
+			{
+				Color: stops[0].Color,
+			},
+		}, stops...)
+	}
+	if stops[len(stops)-1].Offset < 1 {
+		stops = append(stops, GradientStop{
+			Offset: 1,
+			Color:  stops[len(stops)-1].Color,
+		})
+	}
+	return stops
+}
+
+// GetColor returns the color of offset
+func (g *Gradient) GetColor(offset float64) Color {
+	stops := g.getStops()
+	if len(stops) == 0 {
+		return Color{}
+	}
+	offset = math.Max(0, math.Min(1, offset))
+	for i := 1; i < len(stops); i++ {
+		prev := stops[i-1]
+		next := stops[i]
+		if offset > next.Offset {
+			continue
+		}
+		percent := float64(0)
+		if next.Offset > prev.Offset {
+			percent = (offset - prev.Offset) / (next.Offset - prev.Offset)
+		}
+		c := blendColor(prev.Color, next.Color, percent)
+		c.A = uint8(float64(prev.Color.A) + (float64(next.Color.A)-float64(prev.Color.A))*percent + 0.5)
+		return c
+	}
+	return stops[len(stops)-1].Color
+}
+
+// getOffset returns the offset of the relative point(0 to 1 of bounding box)
+func (g *Gradient) getOffset(x, y float64) float64 {
+	if g.IsRadial() {
+		if g.R <= 0 {
+			return 1
+		}
+		return math.Hypot(x-g.X, y-g.Y) / g.R
+	}
+	dx := g.X2 - g.X
+	dy := g.Y2 - g.Y
+	length := dx*dx + dy*dy
+	if length == 0 {
+		return 0
+	}
+	return ((x-g.X)*dx + (y-g.Y)*dy) / length
+}
+
+// getColorAt returns the color of point in the bounding box
+func (g *Gradient) getColorAt(x, y float64, box pathBounds) Color {
+	width := box.maxX - box.minX
+	height := box.maxY - box.minY
+	rx := float64(0)
+	ry := float64(0)
+	if width > 0 {
+		rx = (x - box.minX) / width
+	}
+	if height > 0 {
+		ry = (y - box.minY) / height
+	}
+	return g.GetColor(g.getOffset(rx, ry))
+}
+
+// hasAlpha checks whether the gradient contains translucent color
+func (g *Gradient) hasAlpha() bool {
+	for _, stop := range g.Stops {
+		if stop.Color.A != 255 {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the description of gradient, it is used as the key of gradient
+func (g *Gradient) String() string {
+	format := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	values := []string{
+		g.Type,
+		format(g.X),
+		format(g.Y),
+		format(g.X2),
+		format(g.Y2),
+		format(g.R),
+	}
+	for _, stop := range g.Stops {
+		values = append(values, format(stop.Offset)+":"+stop.Color.String())
+	}
+	return strings.Join(values, ",")
+}
+
+// pathBounds is the bounding box of path
+type pathBounds struct {
+	minX  float64
+	minY  float64
+	maxX  float64
+	maxY  float64
+	valid bool
+	// 当前点
+	x float64
+	y float64
+}
+
+func (b *pathBounds) reset() {
+	*b = pathBounds{}
+}
+
+func (b *pathBounds) add(x, y float64) {
+	if !b.valid {
+		b.minX = x
+		b.maxX = x
+		b.minY = y
+		b.maxY = y
+		b.valid = true
+	} else {
+		b.minX = math.Min(b.minX, x)
+		b.maxX = math.Max(b.maxX, x)
+		b.minY = math.Min(b.minY, y)
+		b.maxY = math.Max(b.maxY, y)
+	}
+	b.x = x
+	b.y = y
+}
+
+// addQuad adds the points of quad curve from current point
+func (b *pathBounds) addQuad(cx, cy, x, y float64) {
+	if !b.valid {
+		b.add(x, y)
+		return
+	}
+	x0 := b.x
+	y0 := b.y
+	count := 16
+	for i := 1; i <= count; i++ {
+		t := float64(i) / float64(count)
+		mt := 1 - t
+		b.add(mt*mt*x0+2*mt*t*cx+t*t*x, mt*mt*y0+2*mt*t*cy+t*t*y)
+	}
+}
+
+// addArc adds the points of arc, the angle is the same as raster renderer
+func (b *pathBounds) addArc(cx, cy, rx, ry, startAngle, delta float64) {
+	// 每一段不超过5度
+	count := int(math.Ceil(math.Abs(delta) / (math.Pi / 36)))
+	if count < 1 {
+		count = 1
+	}
+	for i := 0; i <= count; i++ {
+		angle := startAngle + delta*float64(i)/float64(count)
+		b.add(cx+math.Cos(angle)*rx, cy+math.Sin(angle)*ry)
+	}
+}
+
+// gradientRenderer is the renderer which supports gradient fill
+type gradientRenderer interface {
+	setFillGradient(g *Gradient)
+}
+
+// SetFillGradient sets the gradient fill of the following shapes,
+// it is cleared by reset style or setting nil. The fill color is used
+// if the renderer does not support gradient.
+func (p *Painter) SetFillGradient(g *Gradient) *Painter {
+	if g != nil && len(g.Stops) == 0 {
+		g = nil
+	}
+	if r, ok := p.render.(gradientRenderer); ok {
+		r.setFillGradient(g)
+	}
+	return p
+}
@@ -0,0 +1,290 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"encoding/json"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func newTestGradientStops() []GradientStop {
+	return []GradientStop{
+		{
+			Offset: 1,
+			Color:  drawing.ColorBlue,
+		},
+		{
+			Offset: 0,
+			Color:  drawing.ColorRed,
+		},
+	}
+}
+
+func TestGradient(t *testing.T) {
+	assert := assert.New(t)
+
+	g := NewLinearGradient(0)
+	assert.Equal(&Gradient{
+		Type: GradientTypeLinear,
+		X:    0,
+		Y:    0.5,
+		X2:   1,
+		Y2:   0.5,
+	}, g)
+	g = NewLinearGradient(90, newTestGradientStops()...)
+	assert.Equal(0.5, g.X)
+	assert.Equal(0.0, g.Y)
+	assert.Equal(0.5, g.X2)
+	assert.Equal(1.0, g.Y2)
+	// 45度从左上至右下
+	g45 := NewLinearGradient(45)
+	assert.Equal([]float64{0, 0, 1, 1}, []float64{g45.X, g45.Y, g45.X2, g45.Y2})
+
+	// 颜色节点排序
+	assert.Equal(drawing.ColorRed, g.GetColor(0))
+	assert.Equal(drawing.ColorBlue, g.GetColor(1))
+	assert.Equal(Color{R: 128, B: 128, A: 255}, g.GetColor(0.5))
+	assert.Equal(drawing.ColorBlue, g.GetColor(2))
+
+	// 补全起始及结束节点
+	g = NewLinearGradient(0, GradientStop{
+		Offset: 0.5,
+		Color:  drawing.ColorRed.WithAlpha(0),
+	})
+	stops := g.getStops()
+	assert.Equal(3, len(stops))
+	assert.Equal(0.0, stops[0].Offset)
+	assert.Equal(1.0, stops[2].Offset)
+	assert.True(g.hasAlpha())
+
+	box := pathBounds{}
+	box.add(10, 10)
+	box.add(20, 30)
+	g = NewRadialGradient(0.5, 0.5, 0.5, newTestGradientStops()...)
+	assert.Equal(drawing.ColorRed, g.getColorAt(15, 20, box))
+	assert.Equal(drawing.ColorBlue, g.getColorAt(10, 20, box))
+}
+
+func TestPainterFillGradient(t *testing.T) {
+	assert := assert.New(t)
+
+	g := NewLinearGradient(90, newTestGradientStops()...)
+	fillRect := func(p *Painter) {
+		p.OverrideDrawingStyle(Style{
+			FillColor: drawing.ColorRed,
+		}).SetFillGradient(g).Rect(Box{
+			Right:  20,
+			Bottom: 20,
+		})
+	}
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputPNG,
+		Width:  20,
+		Height: 20,
+	})
+	assert.Nil(err)
+	fillRect(p)
+	img := p.render.(*rasterRenderer).i
+	top := img.RGBAAt(10, 0)
+	bottom := img.RGBAAt(10, 19)
+	assert.True(top.R > 240 && top.B < 15)
+	assert.True(bottom.B > 240 && bottom.R < 15)
+
+	// 重置样式后不再使用渐变
+	p.ResetStyle()
+	p.OverrideDrawingStyle(Style{
+		FillColor: drawing.ColorGreen,
+	}).Rect(Box{
+		Right:  20,
+		Bottom: 20,
+	})
+	assert.Equal(color.RGBA{G: 255, A: 255}, img.RGBAAt(10, 19))
+
+	p, err = NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  20,
+		Height: 20,
+	})
+	assert.Nil(err)
+	fillRect(p)
+	fillRect(p)
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	data := string(buf)
+	assert.Equal(1, strings.Count(data, "<defs>"))
+	assert.Equal(2, strings.Count(data, "fill:url(#gradient-0)"))
+	assert.Contains(data, `<defs><linearGradient id="gradient-0" x1="0.5" y1="0" x2="0.5" y2="1"><stop offset="0" stop-color="rgb(255,0,0)" stop-opacity="1"/><stop offset="1" stop-color="rgb(0,0,255)" stop-opacity="1"/></linearGradient></defs>`)
+
+	p, err = NewPainter(PainterOptions{
+		Type:   ChartOutputPDF,
+		Width:  20,
+		Height: 20,
+	})
+	assert.Nil(err)
+	g = NewRadialGradient(0.5, 0.5, 0.5, GradientStop{
+		Color: drawing.ColorRed,
+	}, GradientStop{
+		Offset: 0.5,
+		Color:  drawing.ColorGreen,
+	}, GradientStop{
+		Offset: 1,
+		Color:  drawing.ColorBlue.WithAlpha(0),
+	})
+	fillRect(p)
+	buf, err = p.Bytes()
+	assert.Nil(err)
+	data = string(buf)
+	assert.Contains(data, "/ShadingType 3 /ColorSpace /DeviceRGB /Coords [0.5 0.5 0 0.5 0.5 0.5] /Function << /FunctionType 3 /Domain [0 1]")
+	assert.Contains(data, "/Bounds [0.5] /Encode [0 1 0 1]")
+	assert.Contains(data, "/SMask << /Type /Mask /S /Luminosity")
+	assert.Contains(data, "/Shading << /Sh1 ")
+}
+
+func TestEChartsGradient(t *testing.T) {
+	assert := assert.New(t)
+
+	opt := EChartsOption{}
+	err := json.Unmarshal([]byte(`{
+		"xAxis": {
+			"data": ["Mon", "Tue"]
+		},
+		"series": [
+			{
+				"type": "line",
+				"data": [1, 2],
+				"itemStyle": {
+					"color": "#5470c6"
+				},
+				"areaStyle": {
+					"color": {
+						"type": "linear",
+						"x2": 0,
+						"y2": 1,
+						"colorStops": [
+							{
+								"offset": 0,
+								"color": "rgba(84,112,198,200)"
+							},
+							{
+								"offset": 1,
+								"color": "rgba(84,112,198,0)"
+							}
+						]
+					}
+				}
+			},
+			{
+				"type": "bar",
+				"data": [
+					1,
+					{
+						"value": 2,
+						"itemStyle": {
+							"color": {
+								"type": "radial",
+								"colorStops": [
+									{
+										"offset": 0,
+										"color": "#fff"
+									},
+									{
+										"offset": 1,
+										"color": "#000"
+									}
+								]
+							}
+						}
+					}
+				]
+			}
+		]
+	}`), &opt)
+	assert.Nil(err)
+	o := opt.ToOption()
+	assert.False(o.FillArea)
+	assert.True(o.SeriesList[0].FillArea)
+	assert.False(o.SeriesList[1].FillArea)
+	assert.Equal(&Gradient{
+		Type: GradientTypeLinear,
+		Y2:   1,
+		Stops: []GradientStop{
+			{
+				Color: Color{R: 84, G: 112, B: 198, A: 200},
+			},
+			{
+				Offset: 1,
+				Color:  Color{R: 84, G: 112, B: 198},
+			},
+		},
+	}, o.SeriesList[0].FillGradient)
+	assert.Equal(Color{R: 84, G: 112, B: 198, A: 255}, o.SeriesList[0].Style.FillColor)
+	assert.Nil(o.SeriesList[1].FillGradient)
+	assert.Nil(o.SeriesList[1].Data[0].FillGradient)
+	assert.Equal(&Gradient{
+		Type: GradientTypeRadial,
+		X:    0.5,
+		Y:    0.5,
+		R:    0.5,
+		Stops: []GradientStop{
+			{
+				Color: drawing.ColorWhite,
+			},
+			{
+				Offset: 1,
+				Color:  drawing.ColorBlack,
+			},
+		},
+	}, o.SeriesList[1].Data[1].FillGradient)
+	assert.Equal(drawing.ColorWhite, o.SeriesList[1].Data[1].Style.FillColor)
+}
+
+func TestEChartsAreaStyle(t *testing.T) {
+	assert := assert.New(t)
+
+	buf, err := RenderEChartsToSVG(`{
+		"xAxis": {
+			"data": ["Mon", "Tue", "Wed"]
+		},
+		"series": [
+			{
+				"type": "line",
+				"data": [1, 3, 2],
+				"areaStyle": {}
+			},
+			{
+				"type": "line",
+				"data": [2, 1, 3]
+			}
+		]
+	}`)
+	assert.Nil(err)
+	// 仅设置了区域样式的series填充区域
+	assert.Contains(string(buf), "fill:rgba(84,112,198,0.8)")
+	assert.NotContains(string(buf), "fill:rgba(145,204,117,0.8)")
+}
@@ -0,0 +1,408 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+const (
+	GraphLayoutForce    = "force"
+	GraphLayoutCircular = "circular"
+)
+
+const (
+	GraphEdgeSymbolNone  = "none"
+	GraphEdgeSymbolArrow = "arrow"
+)
+
+const defaultGraphIterations = 300
+
+type graphChart struct {
+	p   *Painter
+	opt *GraphChartOption
+}
+
+type GraphNode struct {
+	// The name of node, it should be unique
+	Name string
+	// The value of node, the size of node is scaled by it
+	Value float64
+	// The category of node, the color of node is the series color of category
+	Category int
+	// The color of node, it overrides the color of category
+	Color Color
+}
+
+type GraphLink struct {
+	// The name of source node
+	Source string
+	// The name of target node
+	Target string
+}
+
+type GraphOption struct {
+	// The node list
+	Nodes []GraphNode
+	// The link list
+	Links []GraphLink
+	// The layout of graph, it can be "force" or "circular", default is "force"
+	Layout string
+	// The seed of force layout, the same seed generates the same layout
+	Seed int64
+	// The iterations of force layout, default is 300
+	Iterations int
+	// The min radius of node, default is 6
+	MinNodeSize float64
+	// The max radius of node, default is 20
+	MaxNodeSize float64
+	// The curveness of edge, 0 means straight line
+	Curveness float64
+	// The symbol of edge end, it can be "none" or "arrow", default is "none"
+	EdgeSymbol string
+	// The color of edge
+	EdgeColor Color
+	// The flag for show name of node, set this to *false will hide it
+	LabelShow *bool
+}
+
+type GraphChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The padding of graph chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The graph option
+	Graph GraphOption
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewGraphChart returns a graph chart renderer
+func NewGraphChart(p *Painter, opt GraphChartOption) *graphChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &graphChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// forceLayout returns the positions of nodes using
+// Fruchterman-Reingold algorithm, the initial positions are generated from seed
+func forceLayout(count int, links [][2]int, width, height float64, seed int64, iterations int) [][2]float64 {
+	r := rand.New(rand.NewSource(seed))
+	positions := make([][2]float64, count)
+	for index := range positions {
+		positions[index] = [2]float64{
+			r.Float64() * width,
+			r.Float64() * height,
+		}
+	}
+	if count < 2 {
+		return positions
+	}
+	k := math.Sqrt(width * height / float64(count))
+	temperature := width / 10
+	minDistance := 0.01
+	for i := 0; i < iterations; i++ {
+		displacements := make([][2]float64, count)
+		// 斥力
+		for m := 0; m < count; m++ {
+			for n := m + 1; n < count; n++ {
+				dx := positions[m][0] - positions[n][0]
+				dy := positions[m][1] - positions[n][1]
+				distance := math.Max(math.Hypot(dx, dy), minDistance)
+				force := k * k / distance
+				displacements[m][0] += dx / distance * force
+				displacements[m][1] += dy / distance * force
+				displacements[n][0] -= dx / distance * force
+				displacements[n][1] -= dy / distance * force
+			}
+		}
+		// 引力
+		for _, link := range links {
+			source := link[0]
+			target := link[1]
+			dx := positions[source][0] - positions[target][0]
+			dy := positions[source][1] - positions[target][1]
+			distance := math.Max(math.Hypot(dx, dy), minDistance)
+			force := distance * distance / k
+			displacements[source][0] -= dx / distance * force
+			displacements[source][1] -= dy / distance * force
+			displacements[target][0] += dx / distance * force
+			displacements[target][1] += dy / distance * force
+		}
+		// 根据温度限制位移
+		t := temperature * (1 - float64(i)/float64(iterations))
+		for index, d := range displacements {
+			length := math.Max(math.Hypot(d[0], d[1]), minDistance)
+			offset := math.Min(length, t)
+			x := positions[index][0] + d[0]/length*offset
+			y := positions[index][1] + d[1]/length*offset
+			positions[index] = [2]float64{
+				math.Min(math.Max(x, 0), width),
+				math.Min(math.Max(y, 0), height),
+			}
+		}
+	}
+	return positions
+}
+
+// fitPositions scales the positions to fill the box
+func fitPositions(positions [][2]float64, box Box) []Point {
+	minX := math.MaxFloat64
+	minY := math.MaxFloat64
+	maxX := -math.MaxFloat64
+	maxY := -math.MaxFloat64
+	for _, p := range positions {
+		minX = math.Min(minX, p[0])
+		minY = math.Min(minY, p[1])
+		maxX = math.Max(maxX, p[0])
+		maxY = math.Max(maxY, p[1])
+	}
+	scale := func(value, min, max float64, start, size int) int {
+		if max <= min {
+			return start + size>>1
+		}
+		return start + int((value-min)/(max-min)*float64(size))
+	}
+	points := make([]Point, len(positions))
+	for index, p := range positions {
+		points[index] = Point{
+			X: scale(p[0], minX, maxX, box.Left, box.Width()),
+			Y: scale(p[1], minY, maxY, box.Top, box.Height()),
+		}
+	}
+	return points
+}
+
+func (g *graphChart) render(result *defaultRenderResult, opt GraphOption) (Box, error) {
+	count := len(opt.Nodes)
+	if count == 0 {
+		return BoxZero, errors.New("The nodes of graph chart can not be nil")
+	}
+	theme := g.opt.Theme
+	seriesPainter := result.seriesPainter
+
+	nodeIndexes := make(map[string]int)
+	for index, node := range opt.Nodes {
+		nodeIndexes[node.Name] = index
+	}
+	links := make([][2]int, 0, len(opt.Links))
+	for _, link := range opt.Links {
+		source, ok := nodeIndexes[link.Source]
+		if !ok {
+			return BoxZero, errors.New("The source node of link is not found: " + link.Source)
+		}
+		target, ok := nodeIndexes[link.Target]
+		if !ok {
+			return BoxZero, errors.New("The target node of link is not found: " + link.Target)
+		}
+		links = append(links, [2]int{
+			source,
+			target,
+		})
+	}
+
+	// 节点大小
+	minSize := opt.MinNodeSize
+	if minSize <= 0 {
+		minSize = 6
+	}
+	maxSize := opt.MaxNodeSize
+	if maxSize < minSize {
+		maxSize = math.Max(minSize, 20)
+	}
+	minValue := math.MaxFloat64
+	maxValue := -math.MaxFloat64
+	for _, node := range opt.Nodes {
+		minValue = math.Min(minValue, node.Value)
+		maxValue = math.Max(maxValue, node.Value)
+	}
+	radiuses := make([]float64, count)
+	for index, node := range opt.Nodes {
+		if maxValue <= minValue {
+			radiuses[index] = (minSize + maxSize) / 2
+			continue
+		}
+		radiuses[index] = minSize + (node.Value-minValue)/(maxValue-minValue)*(maxSize-minSize)
+	}
+
+	seriesPainter.OverrideTextStyle(Style{
+		FontColor: theme.GetTextColor(),
+		FontSize:  labelFontSize,
+		Font:      g.opt.Font,
+	})
+	labelShow := !isFalse(opt.LabelShow)
+	labelMargin := 5
+	labelHeight := 0
+	labelWidth := 0
+	if labelShow {
+		names := make([]string, count)
+		for index, node := range opt.Nodes {
+			names[index] = node.Name
+		}
+		labelWidth, labelHeight = seriesPainter.MeasureTextMaxWidthHeight(names)
+	}
+
+	// 预留节点及标签的空间
+	horizontalMargin := chart.MaxInt(int(maxSize), labelWidth>>1)
+	verticalMargin := int(maxSize)
+	box := Box{
+		Top:    verticalMargin,
+		Left:   horizontalMargin,
+		Right:  seriesPainter.Width() - horizontalMargin,
+		Bottom: seriesPainter.Height() - verticalMargin - labelHeight - labelMargin,
+	}
+	if box.Width() <= 0 || box.Height() <= 0 {
+		return BoxZero, errors.New("The size of graph chart is too small")
+	}
+
+	var points []Point
+	if opt.Layout == GraphLayoutCircular {
+		center := Point{
+			X: box.Left + box.Width()>>1,
+			Y: box.Top + box.Height()>>1,
+		}
+		radius := float64(chart.MinInt(box.Width(), box.Height())) / 2
+		coordinate := newPolarCoordinate(center, radius, count, -math.Pi/2)
+		points = coordinate.Points(radius)
+	} else {
+		iterations := opt.Iterations
+		if iterations <= 0 {
+			iterations = defaultGraphIterations
+		}
+		positions := forceLayout(count, links, float64(box.Width()), float64(box.Height()), opt.Seed, iterations)
+		points = fitPositions(positions, box)
+	}
+
+	// 连线
+	edgeColor := opt.EdgeColor
+	if edgeColor.IsZero() {
+		edgeColor = theme.GetAxisStrokeColor()
+	}
+	seriesPainter.OverrideDrawingStyle(Style{
+		StrokeColor: edgeColor,
+		StrokeWidth: 1,
+		FillColor:   edgeColor,
+	})
+	arrowWidth := 8
+	arrowHeight := 6
+	for _, link := range links {
+		if link[0] == link[1] {
+			continue
+		}
+		source := points[link[0]]
+		target := points[link[1]]
+		dx := float64(target.X - source.X)
+		dy := float64(target.Y - source.Y)
+		// 曲线的控制点在中点的垂直方向上
+		cx := float64(source.X+target.X)/2 - dy*opt.Curveness
+		cy := float64(source.Y+target.Y)/2 + dx*opt.Curveness
+		// 终点在目标节点的边缘
+		angle := math.Atan2(float64(target.Y)-cy, float64(target.X)-cx)
+		r := radiuses[link[1]] + 1
+		end := Point{
+			X: target.X - int(math.Round(r*math.Cos(angle))),
+			Y: target.Y - int(math.Round(r*math.Sin(angle))),
+		}
+		seriesPainter.MoveTo(source.X, source.Y)
+		if opt.Curveness == 0 {
+			seriesPainter.LineTo(end.X, end.Y)
+		} else {
+			seriesPainter.QuadCurveTo(int(math.Round(cx)), int(math.Round(cy)), end.X, end.Y)
+		}
+		seriesPainter.Stroke()
+		if opt.EdgeSymbol == GraphEdgeSymbolArrow {
+			seriesPainter.Arrow(end.X, end.Y, arrowWidth, arrowHeight, angle)
+		}
+	}
+
+	// 节点
+	for index, node := range opt.Nodes {
+		color := node.Color
+		if color.IsZero() {
+			color = theme.GetSeriesColor(node.Category)
+		}
+		point := points[index]
+		seriesPainter.OverrideDrawingStyle(Style{
+			StrokeColor: theme.GetBackgroundColor(),
+			StrokeWidth: 1,
+			FillColor:   color,
+		})
+		// 两段半圆弧，避免圆形被近似为圆角矩形
+		r := radiuses[index]
+		seriesPainter.ArcTo(point.X, point.Y, r, r, 0, math.Pi)
+		seriesPainter.ArcTo(point.X, point.Y, r, r, math.Pi, math.Pi)
+		seriesPainter.Close()
+		seriesPainter.FillStroke()
+	}
+
+	// 标签
+	if labelShow {
+		for index, node := range opt.Nodes {
+			point := points[index]
+			b := seriesPainter.MeasureText(node.Name)
+			y := point.Y + int(radiuses[index]) + labelMargin + b.Height()
+			seriesPainter.Text(node.Name, point.X-b.Width()>>1, y)
+		}
+	}
+
+	return g.p.box, nil
+}
+
+func (g *graphChart) Render() (Box, error) {
+	p := g.p
+	opt := g.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:   opt.Theme,
+		Padding: opt.Padding,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption:        opt.Title,
+		LegendOption:       opt.Legend,
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return g.render(renderResult, opt.Graph)
+}
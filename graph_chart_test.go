@@ -0,0 +1,174 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForceLayout(t *testing.T) {
+	assert := assert.New(t)
+
+	links := [][2]int{
+		{
+			0,
+			1,
+		},
+		{
+			1,
+			2,
+		},
+	}
+	positions := forceLayout(3, links, 300, 200, 1, 50)
+	assert.Equal(positions, forceLayout(3, links, 300, 200, 1, 50))
+	for _, p := range positions {
+		assert.True(p[0] >= 0 && p[0] <= 300)
+		assert.True(p[1] >= 0 && p[1] <= 200)
+	}
+
+	assert.Equal([]Point{
+		{
+			X: 10,
+			Y: 20,
+		},
+		{
+			X: 110,
+			Y: 70,
+		},
+	}, fitPositions([][2]float64{
+		{
+			1,
+			1,
+		},
+		{
+			3,
+			2,
+		},
+	}, Box{
+		Left:   10,
+		Top:    20,
+		Right:  110,
+		Bottom: 70,
+	}))
+}
+
+func TestGraphChart(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := []GraphNode{
+		{
+			Name:  "gateway",
+			Value: 10,
+		},
+		{
+			Name:     "users",
+			Value:    6,
+			Category: 1,
+		},
+		{
+			Name:     "orders",
+			Value:    8,
+			Category: 1,
+		},
+		{
+			Name:     "postgres",
+			Value:    4,
+			Category: 2,
+		},
+	}
+	links := []GraphLink{
+		{
+			Source: "gateway",
+			Target: "users",
+		},
+		{
+			Source: "gateway",
+			Target: "orders",
+		},
+		{
+			Source: "users",
+			Target: "postgres",
+		},
+		{
+			Source: "orders",
+			Target: "postgres",
+		},
+	}
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewGraphChart(p, GraphChartOption{
+					Title: TitleOption{
+						Text: "Services",
+					},
+					Graph: GraphOption{
+						Nodes:      nodes,
+						Links:      links,
+						Seed:       1,
+						EdgeSymbol: GraphEdgeSymbolArrow,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Services</text><path  d=\"M 575 286\nL 485 66\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 485 75\nL 485 66\nL 491 72\nL 487 71\nL 485 75\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 575 286\nL 134 331\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 142 333\nL 134 331\nL 142 327\nL 139 330\nL 142 333\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 481 55\nL 32 100\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 40 102\nL 32 100\nL 40 96\nL 37 99\nL 40 102\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 118 333\nL 28 107\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 28 116\nL 28 107\nL 34 113\nL 30 112\nL 28 116\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 595 286\nA 20 20 180.00 0 1 555 286\nL 555 286\nA 20 20 180.00 0 1 595 286\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 491 55\nA 10 10 180.00 0 1 471 55\nL 471 55\nA 10 10 180.00 0 1 491 55\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 133 333\nA 15 15 180.00 0 1 103 333\nL 103 333\nA 15 15 180.00 0 1 133 333\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 31 101\nA 6 6 180.00 0 1 19 101\nL 19 101\nA 6 6 180.00 0 1 31 101\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"551\" y=\"323\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">gateway</text><text x=\"465\" y=\"82\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">users</text><text x=\"100\" y=\"365\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">orders</text><text x=\"0\" y=\"124\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">postgres</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewGraphChart(p, GraphChartOption{
+					Graph: GraphOption{
+						Nodes:     nodes,
+						Links:     links,
+						Layout:    GraphLayoutCircular,
+						Curveness: 0.2,
+						LabelShow: FalseFlag(),
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 300 20\nQ349,133 451,177\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 300 20\nQ235,182 294,329\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 462 182\nQ300,117 144,179\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 300 344\nQ251,231 144,185\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:rgba(110,112,121,1.0)\"/><path  d=\"M 320 20\nA 20 20 180.00 0 1 280 20\nL 280 20\nA 20 20 180.00 0 1 320 20\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 472 182\nA 10 10 180.00 0 1 452 182\nL 452 182\nA 10 10 180.00 0 1 472 182\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 315 344\nA 15 15 180.00 0 1 285 344\nL 285 344\nA 15 15 180.00 0 1 315 344\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 144 182\nA 6 6 180.00 0 1 132 182\nL 132 182\nA 6 6 180.00 0 1 144 182\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(250,200,88,1.0)\"/></svg>",
+		},
+	}
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
@@ -58,6 +58,8 @@ func NewGridPainter(p *Painter, opt GridPainterOption) *gridPainter {
 
 func (g *gridPainter) Render() (Box, error) {
 	opt := g.opt
+	g.p.startComponent("split-line")
+	defer g.p.endComponent()
 	ignoreColumnLines := make([]int, 0)
 	if opt.IgnoreFirstColumn {
 		ignoreColumnLines = append(ignoreColumnLines, 0)
@@ -123,10 +123,16 @@ func (h *horizontalBarChart) render(result *defaultRenderResult, seriesList Seri
 			})
 			rendererList = append(rendererList, labelPainter)
 		}
+		seriesPainter.startSeriesGroup(series)
 		for j, item := range series.Data {
 			if j >= yRange.divideCount {
 				continue
 			}
+			dataIndex := j
+			category := ""
+			if len(opt.YAxisOptions) != 0 {
+				category = getCategory(opt.YAxisOptions[0].Data, dataIndex)
+			}
 			// 显示位置切换
 			j = yRange.divideCount - j - 1
 			y := divideValues[j]
@@ -140,26 +146,30 @@ func (h *horizontalBarChart) render(result *defaultRenderResult, seriesList Seri
 			if !item.Style.FillColor.IsZero() {
 				fillColor = item.Style.FillColor
 			}
+			fillGradient := series.FillGradient
+			if item.FillGradient != nil {
+				fillGradient = item.FillGradient
+			}
+			seriesPainter.SetFillGradient(fillGradient)
 			right := w
+			barBox := chart.Box{
+				Top:    y,
+				Left:   0,
+				Right:  right,
+				Bottom: y + barHeight,
+			}
 			if series.RoundRadius <= 0 {
 				seriesPainter.OverrideDrawingStyle(Style{
 					FillColor: fillColor,
-				}).Rect(chart.Box{
-					Top:    y,
-					Left:   0,
-					Right:  right,
-					Bottom: y + barHeight,
-				})
+				}).Rect(barBox)
 			} else {
 				seriesPainter.OverrideDrawingStyle(Style{
 					FillColor: fillColor,
-				}).RoundedRect(chart.Box{
-					Top:    y,
-					Left:   0,
-					Right:  right,
-					Bottom: y + barHeight,
-				}, series.RoundRadius)
+				}).RoundedRect(barBox, series.RoundRadius)
 			}
+			seriesPainter.SetFillGradient(nil)
+			seriesPainter.hitRect(barBox, seriesPainter.formatTooltip(series, category, item.Value))
+			seriesPainter.addSeriesRect(RegionTypeBar, series, dataIndex, item.Value, barBox)
 
 			// 如果label不需要展示，则返回
 			if labelPainter == nil {
@@ -178,7 +188,7 @@ func (h *horizontalBarChart) render(result *defaultRenderResult, seriesList Seri
 			if series.Label.Position == PositionLeft {
 				labelValue.X = 0
 				if labelValue.FontColor.IsZero() {
-					if isLightColor(fillColor) {
+					if seriesPainter.isLightColor(fillColor) {
 						labelValue.FontColor = defaultLightFontColor
 					} else {
 						labelValue.FontColor = defaultDarkFontColor
@@ -187,6 +197,7 @@ func (h *horizontalBarChart) render(result *defaultRenderResult, seriesList Seri
 			}
 			labelPainter.Add(labelValue)
 		}
+		seriesPainter.endGroup()
 	}
 	err := doRender(rendererList...)
 	if err != nil {
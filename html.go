@@ -0,0 +1,176 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// htmlScript toggles the series by clicking the legend item
+const htmlScript = `(function(){` +
+	`var svg=document.currentScript.previousElementSibling;` +
+	`svg.querySelectorAll("[data-series]").forEach(function(item){` +
+	`item.style.cursor="pointer";` +
+	`item.addEventListener("click",function(){` +
+	`var hidden=item.getAttribute("data-hidden")==="true";` +
+	`svg.querySelectorAll(".series-"+item.getAttribute("data-series")).forEach(function(el){el.style.display=hidden?"":"none";});` +
+	`item.setAttribute("data-hidden",hidden?"false":"true");` +
+	`item.style.opacity=hidden?"":"0.4";` +
+	`});` +
+	`});` +
+	`})();`
+
+// newHTMLRenderer returns a svg renderer which output is a html document,
+// the svg contains the tooltip of data and the legend can toggle the series
+func newHTMLRenderer(width, height int) (chart.Renderer, error) {
+	r, err := newSVGRenderer(width, height)
+	if err != nil {
+		return nil, err
+	}
+	vr := r.(*svgRenderer)
+	vr.interactive = true
+	vr.semantic = true
+	vr.attributes = append(vr.attributes, svgAttribute{
+		Name:  "class",
+		Value: svgClassName,
+	})
+	return vr, nil
+}
+
+// saveHTML writes the html document of svg
+func (vr *svgRenderer) saveHTML(w io.Writer, svg []byte) error {
+	buf := bytes.Buffer{}
+	buf.WriteString(`<!DOCTYPE html><html><head><meta charset="utf-8">`)
+	if vr.htmlTitle != "" {
+		buf.WriteString("<title>" + html.EscapeString(vr.htmlTitle) + "</title>")
+	}
+	buf.WriteString("</head><body>")
+	buf.Write(svg)
+	buf.WriteString("<script>" + htmlScript + "</script></body></html>")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// getInteractiveRenderer returns the svg renderer of html output
+func (p *Painter) getInteractiveRenderer() *svgRenderer {
+	r := p.getSVGRenderer()
+	if r == nil || !r.interactive {
+		return nil
+	}
+	return r
+}
+
+// setHTMLTitle sets the title of html document
+func (p *Painter) setHTMLTitle(title string) {
+	if r := p.getInteractiveRenderer(); r != nil {
+		r.htmlTitle = title
+	}
+}
+
+// getCategory returns the category of data index
+func getCategory(data []string, index int) string {
+	if index < 0 || index >= len(data) {
+		return ""
+	}
+	return data[index]
+}
+
+// formatTooltip returns the tooltip of data, which contains series name, category and value
+func (p *Painter) formatTooltip(series Series, category string, value float64) string {
+	text := p.formatValue(value)
+	if category != "" {
+		text = category + ": " + text
+	}
+	return getAccessibleName(series) + "\n" + text
+}
+
+// hitRect adds a transparent rect with tooltip for html output
+func (p *Painter) hitRect(box Box, tooltip string) *Painter {
+	r := p.getInteractiveRenderer()
+	if r == nil {
+		return p
+	}
+	r.write(fmt.Sprintf(`<rect x="%d" y="%d" width="%d" height="%d" fill="transparent"><title>%s</title></rect>`,
+		box.Left+p.box.Left,
+		box.Top+p.box.Top,
+		box.Width(),
+		box.Height(),
+		html.EscapeString(tooltip),
+	))
+	return p
+}
+
+// hitCircle adds a transparent circle with tooltip for html output
+func (p *Painter) hitCircle(x, y, radius int, tooltip string) *Painter {
+	r := p.getInteractiveRenderer()
+	if r == nil {
+		return p
+	}
+	r.write(fmt.Sprintf(`<circle cx="%d" cy="%d" r="%d" fill="transparent"><title>%s</title></circle>`,
+		x+p.box.Left,
+		y+p.box.Top,
+		radius,
+		html.EscapeString(tooltip),
+	))
+	return p
+}
+
+// groupTooltip adds the tooltip to the current group for html output,
+// it should be called after the group is started
+func (p *Painter) groupTooltip(tooltip string) *Painter {
+	r := p.getInteractiveRenderer()
+	if r == nil {
+		return p
+	}
+	r.write("<title>" + html.EscapeString(tooltip) + "</title>")
+	return p
+}
+
+// startLegendItem starts the group of legend item, which toggles the series for html output
+func (p *Painter) startLegendItem(index int) *Painter {
+	r := p.getInteractiveRenderer()
+	if r == nil {
+		return p
+	}
+	r.startGroup(svgAttribute{
+		Name:  "class",
+		Value: "legend-item",
+	}, svgAttribute{
+		Name:  "data-series",
+		Value: fmt.Sprintf("%d", index),
+	})
+	return p
+}
+
+// endLegendItem ends the group of legend item
+func (p *Painter) endLegendItem() *Painter {
+	if r := p.getInteractiveRenderer(); r != nil {
+		r.endGroup()
+	}
+	return p
+}
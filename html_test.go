@@ -0,0 +1,135 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func TestHTMLRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputHTML,
+		Width:  100,
+		Height: 50,
+	})
+	assert.Nil(err)
+	p.setHTMLTitle("A<B")
+	p.startLegendItem(0)
+	p.SetDrawingStyle(Style{
+		FillColor: drawing.ColorBlack,
+	})
+	p.Circle(5, 10, 10)
+	p.endLegendItem()
+	series := Series{
+		Name: "Email",
+	}
+	p.startSeriesGroup(series)
+	p.hitRect(Box{
+		Left:   10,
+		Top:    10,
+		Right:  20,
+		Bottom: 30,
+	}, p.formatTooltip(series, "Mon", 1234.5))
+	p.hitCircle(5, 5, 6, p.formatTooltip(series, "", 1))
+	p.endGroup()
+
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal(`<!DOCTYPE html><html><head><meta charset="utf-8"><title>A&lt;B</title></head><body><svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="100" height="50" class="go-charts">\n<g class="legend-item" data-series="0"><circle cx="10" cy="10" r="5" style="stroke-width:0;stroke:none;fill:rgba(0,0,0,1.0)"/></g><g class="series series-0"><rect x="10" y="10" width="10" height="20" fill="transparent"><title>Email
+Mon: 1,234.5</title></rect><circle cx="5" cy="5" r="6" fill="transparent"><title>Email
+1</title></circle></g></svg><script>`+htmlScript+`</script></body></html>`, string(buf))
+
+	// svg不输出提示
+	p, err = NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  100,
+		Height: 50,
+	})
+	assert.Nil(err)
+	p.startLegendItem(0)
+	p.hitCircle(5, 5, 6, "tooltip")
+	p.endLegendItem()
+	buf, err = p.Bytes()
+	assert.Nil(err)
+	assert.Equal(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="100" height="50">\n</svg>`, string(buf))
+}
+
+func TestChartHTML(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := BarRender([][]float64{
+		{120, 132},
+		{220, 182},
+	},
+		HTMLTypeOption(),
+		TitleTextOptionFunc("Traffic"),
+		XAxisDataOptionFunc([]string{"Mon", "Tue"}),
+		LegendLabelsOptionFunc([]string{"Email", "Union Ads"}),
+		func(opt *ChartOption) {
+			opt.ValueFormatter = func(f float64) string {
+				return strconv.Itoa(int(f)) + " visits"
+			}
+		},
+	)
+	assert.Nil(err)
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	data := string(buf)
+	assert.True(strings.HasPrefix(data, `<!DOCTYPE html><html><head><meta charset="utf-8"><title>Traffic</title>`))
+	assert.Equal(2, strings.Count(data, `class="legend-item"`))
+	assert.Contains(data, `<g class="series series-1">`)
+	assert.Contains(data, "<title>Email\nMon: 120 visits</title>")
+	assert.Contains(data, "<title>Union Ads\nTue: 182 visits</title>")
+
+	_, err = p.Image()
+	assert.Equal(ErrImageNotSupported, err)
+}
+
+func TestChartHTMLEscape(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := LineRender([][]float64{
+		{120, 132},
+	},
+		HTMLTypeOption(),
+		XAxisDataOptionFunc([]string{"</text><script>alert(1)</script>", "R&D"}),
+		LegendLabelsOptionFunc([]string{"<img src=x onerror=alert(2)>"}),
+	)
+	assert.Nil(err)
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	data := string(buf)
+	assert.NotContains(data, "<script>alert(1)</script>")
+	assert.NotContains(data, "<img")
+	assert.NotContains(data, "R&D")
+	assert.Contains(data, "&lt;/text&gt;&lt;script&gt;alert(1)&lt;/script&gt;</text>")
+	assert.Contains(data, "&lt;img src=x onerror=alert(2)&gt;</text>")
+	assert.Contains(data, ">R&amp;D</text>")
+}
@@ -25,6 +25,8 @@ package charts
 import (
 	"strconv"
 	"strings"
+
+	"github.com/wcharczuk/go-chart/v2"
 )
 
 type legendPainter struct {
@@ -96,6 +98,8 @@ func NewLegendPainter(p *Painter, opt LegendOption) *legendPainter {
 
 func (l *legendPainter) Render() (Box, error) {
 	opt := l.opt
+	l.p.startComponent("legend")
+	defer l.p.endComponent()
 	theme := opt.Theme
 	if opt.IsEmpty() ||
 		isFalse(opt.Show) {
@@ -224,6 +228,8 @@ func (l *legendPainter) Render() (Box, error) {
 			y += itemMaxHeight
 			y0 = y
 		}
+		p.startLegendItem(index)
+		itemLeft := x0
 		if opt.Align != AlignRight {
 			x0 = drawIcon(y0, x0)
 			x0 += textOffset
@@ -234,6 +240,19 @@ func (l *legendPainter) Render() (Box, error) {
 			x0 += textOffset
 			x0 = drawIcon(y0, x0)
 		}
+		p.endLegendItem()
+		p.addRegion(Region{
+			Type:        RegionTypeLegend,
+			SeriesIndex: index,
+			DataIndex:   -1,
+			Name:        text,
+			Box: Box{
+				Left:   itemLeft,
+				Top:    y0 - chart.MaxInt(measureList[index].Height(), legendHeight-8),
+				Right:  x0,
+				Bottom: y0 + 2,
+			},
+		})
 		if opt.Orient == OrientVertical {
 			y0 += offset
 			x0 = x
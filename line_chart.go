@@ -72,6 +72,8 @@ type LineChartOption struct {
 	backgroundIsFilled bool
 	// background fill (alpha) opacity
 	Opacity uint8
+	// The sparkline option
+	Sparkline SparklineOption
 }
 
 func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
@@ -83,6 +85,10 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 	}
 
 	seriesPainter := result.seriesPainter
+	// 无数据时不绘制，如未有数据的sparkline
+	if len(opt.XAxis.Data) == 0 || !seriesList.hasValue() {
+		return p.box, nil
+	}
 
 	xDivideCount := len(opt.XAxis.Data)
 	if !boundaryGap {
@@ -156,8 +162,9 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 				FontSize: series.Label.FontSize,
 			})
 		}
+		seriesPainter.startSeriesGroup(series)
 		// 如果需要填充区域
-		if opt.FillArea {
+		if opt.FillArea || series.FillArea {
 			areaPoints := make([]Point, len(points))
 			copy(areaPoints, points)
 			bottomY := yRange.getRestHeight(yRange.min)
@@ -175,7 +182,9 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 			seriesPainter.SetDrawingStyle(Style{
 				FillColor: seriesColor.WithAlpha(opacity),
 			})
+			seriesPainter.SetFillGradient(series.FillGradient)
 			seriesPainter.FillArea(areaPoints)
+			seriesPainter.SetFillGradient(nil)
 		}
 		seriesPainter.SetDrawingStyle(drawingStyle)
 
@@ -190,9 +199,21 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 		}
 		drawingStyle.StrokeWidth = 1
 		seriesPainter.SetDrawingStyle(drawingStyle)
-		if !isFalse(opt.SymbolShow) {
+		// 终端中的点小于一个字符点位，会遮挡线条，因此不展示
+		if !isFalse(opt.SymbolShow) && !p.isTerminal() {
 			seriesPainter.Dots(points)
 		}
+		if opt.Sparkline.Enabled {
+			opt.Sparkline.renderHighlights(seriesPainter, points, series.Data, seriesColor)
+		}
+		for i, point := range points {
+			if series.Data[i].Value == nullValue {
+				continue
+			}
+			seriesPainter.hitCircle(point.X, point.Y, 6, seriesPainter.formatTooltip(series, getCategory(opt.XAxis.Data, i), series.Data[i].Value))
+			seriesPainter.addSeriesPoint(series, i, series.Data[i].Value, point.X, point.Y, 6)
+		}
+		seriesPainter.endGroup()
 		markPointPainter.Add(markPointRenderOption{
 			FillColor: seriesColor,
 			Font:      opt.Font,
@@ -230,6 +251,7 @@ func (l *lineChart) Render() (Box, error) {
 		TitleOption:        opt.Title,
 		LegendOption:       opt.Legend,
 		backgroundIsFilled: opt.backgroundIsFilled,
+		sparkline:          opt.Sparkline.Enabled,
 	})
 	if err != nil {
 		return BoxZero, err
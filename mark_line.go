@@ -67,6 +67,8 @@ type markLineRenderOption struct {
 
 func (m *markLinePainter) Render() (Box, error) {
 	painter := m.p
+	painter.startComponent("mark-line")
+	defer painter.endComponent()
 	for _, opt := range m.options {
 		s := opt.Series
 		if len(s.MarkLine.Data) == 0 {
@@ -65,6 +65,8 @@ func NewMarkPointPainter(p *Painter) *markPointPainter {
 
 func (m *markPointPainter) Render() (Box, error) {
 	painter := m.p
+	painter.startComponent("mark-point")
+	defer painter.endComponent()
 	for _, opt := range m.options {
 		s := opt.Series
 		if len(s.MarkPoint.Data) == 0 {
@@ -81,7 +83,7 @@ func (m *markPointPainter) Render() (Box, error) {
 			StrokeWidth: 1,
 			Font:        opt.Font,
 		}
-		if isLightColor(opt.FillColor) {
+		if painter.isLightColor(opt.FillColor) {
 			textStyle.FontColor = defaultLightFontColor
 		} else {
 			textStyle.FontColor = defaultDarkFontColor
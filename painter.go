@@ -25,6 +25,10 @@ package charts
 import (
 	"bytes"
 	"errors"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"io"
 	"math"
 
 	"github.com/golang/freetype/truetype"
@@ -42,11 +46,16 @@ type Painter struct {
 	theme  ColorPalette
 	// 类型
 	outputType     string
+	pixelRatio     float64
 	valueFormatter ValueFormatter
+	// 点击区域
+	regions *regionCollector
+	// 图表背后假定的背景色
+	backdrop Color
 }
 
 type PainterOptions struct {
-	// Draw type, "svg" or "png", default type is "png"
+	// Draw type, "svg", "png", "jpeg", "gif", "pdf", "html" or "terminal", default type is "png"
 	Type string
 	// The width of draw painter
 	Width int
@@ -54,6 +63,9 @@ type PainterOptions struct {
 	Height int
 	// The font for painter
 	Font *truetype.Font
+	// The device pixel ratio of png, jpeg and gif output, the image size is scaled
+	// by it while the drawing still uses the logical width and height, e.g. 2 for retina
+	DevicePixelRatio float64
 }
 
 type PainterOption func(*Painter)
@@ -78,6 +90,8 @@ type MultiTextOption struct {
 	Offset       Box
 	// The first text index
 	First int
+	// 是否记录坐标轴标签的点击区域
+	axisLabel bool
 }
 
 type GridOption struct {
@@ -149,6 +163,70 @@ func PainterWidthHeightOption(width, height int) PainterOption {
 	}
 }
 
+// ErrImageNotSupported is returned when the painter is not a raster painter
+var ErrImageNotSupported = errors.New("image is only supported by png, jpeg and gif painter")
+
+// ErrPainterReleased is returned when the painter is released
+var ErrPainterReleased = errors.New("painter is released")
+
+// releaser is implemented by the renderer which can release its buffer for reusing
+type releaser interface {
+	release()
+}
+
+// countWriter counts the bytes written to writer
+type countWriter struct {
+	w     io.Writer
+	count int64
+}
+
+func (c *countWriter) Write(buf []byte) (int, error) {
+	n, err := c.w.Write(buf)
+	c.count += int64(n)
+	return n, err
+}
+
+// isRasterOutput returns true if the output type is drawn by raster renderer
+func isRasterOutput(outputType string) bool {
+	switch outputType {
+	case ChartOutputSVG, ChartOutputPDF, ChartOutputHTML, ChartOutputTerminal:
+		return false
+	}
+	return true
+}
+
+// rgbaCollector collects the image of raster renderer without encoding
+type rgbaCollector struct {
+	rgba *image.RGBA
+}
+
+func (c *rgbaCollector) SetRGBA(rgba *image.RGBA) {
+	c.rgba = rgba
+}
+
+func (c *rgbaCollector) Write(buf []byte) (int, error) {
+	return 0, ErrImageNotSupported
+}
+
+// newRenderer returns the renderer of output type, default is png.
+// The pixel ratio is only used by raster renderer.
+func newRenderer(outputType string, width, height int, pixelRatio float64) (chart.Renderer, error) {
+	switch outputType {
+	case ChartOutputSVG:
+		return newSVGRenderer(width, height)
+	case ChartOutputPDF:
+		return newPDFRenderer(width, height)
+	case ChartOutputHTML:
+		return newHTMLRenderer(width, height)
+	case ChartOutputTerminal:
+		return newTerminalRenderer(width, height)
+	}
+	if pixelRatio > 0 && pixelRatio != 1 {
+		return newPixelRatioRenderer(width, height, pixelRatio)
+	}
+	return newRasterRenderer(width, height)
+}
+
 // NewPainter creates a painter
 func NewPainter(opts PainterOptions, opt ...PainterOption) (*Painter, error) {
 	if opts.Width <= 0 || opts.Height <= 0 {
@@ -162,13 +240,9 @@ func NewPainter(opts PainterOptions, opt ...PainterOption) (*Painter, error) {
 		}
 		font = f
 	}
-	fn := chart.PNG
-	if opts.Type == ChartOutputSVG {
-		fn = chart.SVG
-	}
 	width := opts.Width
 	height := opts.Height
-	r, err := fn(width, height)
+	r, err := newRenderer(opts.Type, width, height, opts.DevicePixelRatio)
 	if err != nil {
 		return nil, err
 	}
@@ -183,6 +257,8 @@ func NewPainter(opts PainterOptions, opt ...PainterOption) (*Painter, error) {
 		font: font,
 		// 类型
 		outputType: opts.Type,
+		pixelRatio: opts.DevicePixelRatio,
+		regions:    &regionCollector{},
 	}
 	p.setOptions(opt...)
 	if p.theme == nil {
@@ -207,6 +283,11 @@ func (p *Painter) Child(opt ...PainterOption) *Painter {
 		parent: p,
 		style:  p.style,
 		theme:  p.theme,
+		// 类型
+		outputType: p.outputType,
+		pixelRatio: p.pixelRatio,
+		regions:    p.regions,
+		backdrop:   p.backdrop,
 	}
 	child.setOptions(opt...)
 	return child
@@ -277,19 +358,92 @@ func (p *Painter) OverrideTextStyle(style Style) *Painter {
 
 func (p *Painter) ResetStyle() *Painter {
 	p.style.WriteToRenderer(p.render)
+	p.SetFillGradient(nil)
 	return p
 }
 
+// encode writes the data of draw canvas to writer
+func (p *Painter) encode(w io.Writer) error {
+	switch p.outputType {
+	case ChartOutputJPEG:
+		return p.EncodeJPEG(w, nil)
+	case ChartOutputGIF:
+		return p.EncodeGIF(w, nil)
+	}
+	return p.render.Save(w)
+}
+
 // Bytes returns the data of draw canvas
 func (p *Painter) Bytes() ([]byte, error) {
 	buffer := bytes.Buffer{}
-	err := p.render.Save(&buffer)
+	err := p.encode(&buffer)
 	if err != nil {
 		return nil, err
 	}
 	return buffer.Bytes(), err
 }
 
+// WriteTo writes the data of draw canvas to writer without buffering,
+// e.g. writes the chart to http response directly
+func (p *Painter) WriteTo(w io.Writer) (int64, error) {
+	cw := &countWriter{
+		w: w,
+	}
+	err := p.encode(cw)
+	return cw.count, err
+}
+
+// Release puts the raster buffer of painter back to the pool, which will be reused
+// by the next painter of the same size. The drawing of painter is ignored after released
+// and the image returned by Image can not be used, it does nothing for svg and pdf painter.
+// Only the buffers of a few image sizes are pooled, the others are left to the gc.
+func (p *Painter) Release() {
+	if r, ok := p.render.(releaser); ok {
+		r.release()
+	}
+}
+
+// Image returns the rgba image of raster painter, it shares the pixels
+// with the painter, so the following drawing will change it too.
+func (p *Painter) Image() (image.Image, error) {
+	if !isRasterOutput(p.outputType) {
+		return nil, ErrImageNotSupported
+	}
+	collector := &rgbaCollector{}
+	err := p.render.Save(collector)
+	if err != nil {
+		return nil, err
+	}
+	if collector.rgba == nil {
+		return nil, ErrImageNotSupported
+	}
+	return collector.rgba, nil
+}
+
+// EncodeJPEG encodes the image of raster painter as jpeg,
+// the default quality is used if the options is nil.
+// The transparent pixels are blended with the backdrop color.
+func (p *Painter) EncodeJPEG(w io.Writer, opts *jpeg.Options) error {
+	img, err := p.Image()
+	if err != nil {
+		return err
+	}
+	img = flattenImage(img, p.getBackdrop())
+	return jpeg.Encode(w, img, opts)
+}
+
+// EncodeGIF encodes the image of raster painter as gif,
+// the plan9 palette is used if the options is nil.
+// The transparent pixels are blended with the backdrop color.
+func (p *Painter) EncodeGIF(w io.Writer, opts *gif.Options) error {
+	img, err := p.Image()
+	if err != nil {
+		return err
+	}
+	img = flattenImage(img, p.getBackdrop())
+	return gif.Encode(w, img, opts)
+}
+
 // MoveTo moves the cursor to a given point
 func (p *Painter) MoveTo(x, y int) *Painter {
 	p.render.MoveTo(x+p.box.Left, y+p.box.Top)
@@ -398,6 +552,32 @@ func (p *Painter) ArrowBottom(x, y, width, height int) *Painter {
 	return p
 }
 
+// Arrow draws an arrow whose tip is (x, y) and points to the angle(radians)
+func (p *Painter) Arrow(x, y, width, height int, angle float64) *Painter {
+	w := float64(width)
+	h := float64(height) / 2
+	// 以尖端为原点的右向箭头
+	points := [][2]float64{
+		{-w, -h},
+		{0, 0},
+		{-w, h},
+		{-w * 2 / 3, 0},
+		{-w, -h},
+	}
+	sin, cos := math.Sincos(angle)
+	for index, point := range points {
+		px := x + int(math.Round(point[0]*cos-point[1]*sin))
+		py := y + int(math.Round(point[0]*sin+point[1]*cos))
+		if index == 0 {
+			p.MoveTo(px, py)
+		} else {
+			p.LineTo(px, py)
+		}
+	}
+	p.FillStroke()
+	return p
+}
+
 func (p *Painter) Circle(radius float64, x, y int) *Painter {
 	p.render.Circle(radius, x+p.box.Left, y+p.box.Top)
 	return p
@@ -615,6 +795,29 @@ func (p *Painter) TextFit(body string, x, y, width int, textAligns ...string) ch
 	return output
 }
 
+// MeasureTextFit returns the box of text which is wrapped to fit the width,
+// it is the same as the box returned by TextFit
+func (p *Painter) MeasureTextFit(body string, width int) Box {
+	style := p.style
+	style.TextWrap = chart.TextWrapWord
+	r := p.render
+	lines := chart.Text.WrapFit(r, body, width, style)
+	p.SetTextStyle(style)
+	var output Box
+	for index, line := range lines {
+		if line == "" {
+			continue
+		}
+		lineBox := r.MeasureText(line)
+		output.Right = chart.MaxInt(lineBox.Right, output.Right)
+		output.Bottom += lineBox.Height()
+		if index < len(lines)-1 {
+			output.Bottom += style.GetTextLineSpacing()
+		}
+	}
+	return output
+}
+
 func (p *Painter) Ticks(opt TicksOption) *Painter {
 	if opt.Count <= 0 || opt.Length <= 0 {
 		return p
@@ -729,6 +932,20 @@ func (p *Painter) MultiText(opt MultiTextOption) *Painter {
 		x += offset.Left
 		y += offset.Top
 		p.Text(text, x, y)
+		if opt.axisLabel {
+			p.addRegion(Region{
+				Type:        RegionTypeAxisLabel,
+				SeriesIndex: -1,
+				DataIndex:   index,
+				Name:        text,
+				Box: Box{
+					Left:   x,
+					Top:    y - box.Height(),
+					Right:  x + box.Width(),
+					Bottom: y,
+				},
+			})
+		}
 	}
 	if isTextRotation {
 		p.ClearTextRotation()
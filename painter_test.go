@@ -23,6 +23,11 @@
 package charts
 
 import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
 	"math"
 	"testing"
 
@@ -248,6 +253,28 @@ func TestPainter(t *testing.T) {
 			},
 			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"400\" height=\"300\">\\n<path  d=\"M 30 24\nL 35 40\nL 40 24\nL 35 30\nL 30 24\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/></svg>",
 		},
+		// arrow of angle
+		{
+			fn: func(p *Painter) {
+				p.SetStyle(Style{
+					StrokeWidth: 1,
+					StrokeColor: Color{
+						R: 84,
+						G: 112,
+						B: 198,
+						A: 255,
+					},
+					FillColor: Color{
+						R: 84,
+						G: 112,
+						B: 198,
+						A: 255,
+					},
+				})
+				p.Arrow(30, 30, 16, 10, math.Pi/2)
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"400\" height=\"300\">\\n<path  d=\"M 40 24\nL 35 40\nL 30 24\nL 35 29\nL 40 24\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/></svg>",
+		},
 		// mark line
 		{
 			fn: func(p *Painter) {
@@ -387,6 +414,8 @@ func TestPainterTextFit(t *testing.T) {
 		Bottom: 35,
 	}, box)
 
+	assert.Equal(box, p.MeasureTextFit("Hello World!", 80))
+
 	box = p.TextFit("Hello World!", 0, 100, 200)
 	assert.Equal(chart.Box{
 		Right:  84,
@@ -397,3 +426,149 @@ func TestPainterTextFit(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="400" height="300">\n<text x="0" y="20" style="stroke-width:0;stroke:none;fill:rgba(51,51,51,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif">Hello</text><text x="0" y="40" style="stroke-width:0;stroke:none;fill:rgba(51,51,51,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif">World!</text><text x="0" y="100" style="stroke-width:0;stroke:none;fill:rgba(51,51,51,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif">Hello World!</text></svg>`, string(buf))
 }
+
+func TestPainterImage(t *testing.T) {
+	assert := assert.New(t)
+
+	newPainter := func(outputType string) *Painter {
+		p, err := NewPainter(PainterOptions{
+			Width:  40,
+			Height: 30,
+			Type:   outputType,
+		})
+		assert.Nil(err)
+		p.SetDrawingStyle(Style{
+			FillColor: drawing.ColorRed,
+		})
+		p.Rect(Box{
+			Right:  20,
+			Bottom: 10,
+		})
+		return p
+	}
+
+	p := newPainter(ChartOutputPNG)
+	img, err := p.Image()
+	assert.Nil(err)
+	assert.Equal(image.Rect(0, 0, 40, 30), img.Bounds())
+	assert.Equal(color.RGBA{
+		R: 255,
+		A: 255,
+	}, img.At(5, 5))
+	img, err = p.Child(PainterPaddingOption(Box{
+		Left: 10,
+	})).Image()
+	assert.Nil(err)
+	assert.Equal(image.Rect(0, 0, 40, 30), img.Bounds())
+
+	buf, err := newPainter(ChartOutputJPEG).Bytes()
+	assert.Nil(err)
+	img, format, err := image.Decode(bytes.NewReader(buf))
+	assert.Nil(err)
+	assert.Equal("jpeg", format)
+	assert.Equal(image.Rect(0, 0, 40, 30), img.Bounds())
+
+	buf, err = newPainter(ChartOutputGIF).Bytes()
+	assert.Nil(err)
+	img, format, err = image.Decode(bytes.NewReader(buf))
+	assert.Nil(err)
+	assert.Equal("gif", format)
+	assert.Equal(image.Rect(0, 0, 40, 30), img.Bounds())
+
+	_, err = newPainter(ChartOutputSVG).Image()
+	assert.Equal(ErrImageNotSupported, err)
+	_, err = newPainter(ChartOutputPDF).Image()
+	assert.Equal(ErrImageNotSupported, err)
+}
+
+func TestPainterWriteTo(t *testing.T) {
+	assert := assert.New(t)
+
+	newPainter := func(outputType string) *Painter {
+		p, err := NewPainter(PainterOptions{
+			Width:  40,
+			Height: 30,
+			Type:   outputType,
+		})
+		assert.Nil(err)
+		p.SetDrawingStyle(Style{
+			FillColor: drawing.ColorRed,
+		})
+		p.Rect(Box{
+			Right:  20,
+			Bottom: 10,
+		})
+		return p
+	}
+
+	for _, outputType := range []string{
+		ChartOutputSVG,
+		ChartOutputPNG,
+		ChartOutputJPEG,
+	} {
+		data, err := newPainter(outputType).Bytes()
+		assert.Nil(err)
+		buf := bytes.Buffer{}
+		n, err := newPainter(outputType).WriteTo(&buf)
+		assert.Nil(err)
+		assert.Equal(int64(len(data)), n)
+		assert.Equal(data, buf.Bytes())
+	}
+}
+
+func TestPainterRelease(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Width:  40,
+		Height: 30,
+		Type:   ChartOutputPNG,
+	})
+	assert.Nil(err)
+	p.SetBackground(40, 30, drawing.ColorRed)
+	p.Release()
+	_, err = p.Bytes()
+	assert.Equal(ErrPainterReleased, err)
+	_, err = p.Image()
+	assert.Equal(ErrPainterReleased, err)
+	released := p
+
+	// 复用的图片需要为透明
+	p, err = NewPainter(PainterOptions{
+		Width:  40,
+		Height: 30,
+		Type:   ChartOutputPNG,
+	})
+	assert.Nil(err)
+	img, err := p.Image()
+	assert.Nil(err)
+	assert.Equal(color.RGBA{}, img.At(10, 10))
+	// 已释放的painter不再绘制到复用的图片
+	released.SetBackground(40, 30, drawing.ColorRed)
+	released.Text("Hello", 0, 20)
+	assert.Equal(color.RGBA{}, img.At(10, 10))
+	p.Release()
+
+	// 仅缓存有限尺寸的图片
+	for i := 0; i < maxRGBAPoolCount+2; i++ {
+		p, err = NewPainter(PainterOptions{
+			Width:  10 + i,
+			Height: 10,
+			Type:   ChartOutputPNG,
+		})
+		assert.Nil(err)
+		p.Release()
+	}
+	assert.True(len(rgbaPools) <= maxRGBAPoolCount)
+
+	// svg painter忽略release
+	p, err = NewPainter(PainterOptions{
+		Width:  40,
+		Height: 30,
+		Type:   ChartOutputSVG,
+	})
+	assert.Nil(err)
+	p.Release()
+	_, err = p.Bytes()
+	assert.Nil(err)
+}
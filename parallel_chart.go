@@ -0,0 +1,288 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type parallelChart struct {
+	p   *Painter
+	opt *ParallelChartOption
+}
+
+type ParallelAxis struct {
+	// The name of axis(dimension)
+	Name string
+	// The minimum value of axis, it is calculated by data if it is nil
+	Min *float64
+	// The maximum value of axis, it is calculated by data if it is nil
+	Max *float64
+}
+
+type ParallelOption struct {
+	// The axis list, one axis for each dimension
+	Axes []ParallelAxis
+	// Number of segments that the axis is split into, default is 5
+	SplitNumber int
+	// The stroke width of line
+	StrokeWidth float64
+	// The opacity(alpha) of line, it is useful for large record count.
+	// Default value is 255
+	Opacity uint8
+}
+
+type ParallelChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list, each series is a record
+	SeriesList SeriesList
+	// The padding of parallel chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The parallel option
+	Parallel ParallelOption
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewParallelAxes returns a parallel axis list
+func NewParallelAxes(names []string) []ParallelAxis {
+	axes := make([]ParallelAxis, len(names))
+	for index, name := range names {
+		axes[index] = ParallelAxis{
+			Name: name,
+		}
+	}
+	return axes
+}
+
+// NewParallelChart returns a parallel coordinates chart renderer
+func NewParallelChart(p *Painter, opt ParallelChartOption) *parallelChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &parallelChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+func (pc *parallelChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	opt := pc.opt
+	axes := opt.Parallel.Axes
+	count := len(axes)
+	if count < 2 {
+		return BoxZero, errors.New("The count of parallel axis should be >= 2")
+	}
+	seriesPainter := result.seriesPainter
+	theme := opt.Theme
+	divideCount := opt.Parallel.SplitNumber
+	if divideCount <= 0 {
+		divideCount = defaultAxisDivideCount
+	}
+
+	seriesPainter.OverrideTextStyle(Style{
+		FontColor: theme.GetTextColor(),
+		FontSize:  theme.GetFontSize(),
+		Font:      opt.Font,
+	})
+	names := make([]string, count)
+	for index, axis := range axes {
+		names[index] = axis.Name
+	}
+	_, nameHeight := seriesPainter.MeasureTextMaxWidthHeight(names)
+	labelMargin := 10
+	tickLength := 5
+	// 预留名称及首个标签的一半高度
+	top := nameHeight + labelMargin + nameHeight>>1
+	height := seriesPainter.Height() - top
+	if height <= 0 {
+		return BoxZero, errors.New("The height of parallel chart is too small")
+	}
+
+	ranges := make([]axisRange, count)
+	labelsList := make([][]string, count)
+	for index, axis := range axes {
+		max := -nullValue
+		min := nullValue
+		for _, series := range seriesList {
+			if index >= len(series.Data) {
+				continue
+			}
+			value := series.Data[index].Value
+			if value == nullValue {
+				continue
+			}
+			max = math.Max(max, value)
+			min = math.Min(min, value)
+		}
+		if min > max {
+			min = 0
+			max = 0
+		}
+		r := NewRange(AxisRangeOption{
+			Painter:     seriesPainter,
+			Min:         min,
+			Max:         max,
+			Size:        height,
+			DivideCount: divideCount,
+		})
+		if axis.Min != nil {
+			r.min = *axis.Min
+		}
+		if axis.Max != nil {
+			r.max = *axis.Max
+		}
+		ranges[index] = r
+		labelsList[index] = r.Values()
+	}
+
+	// 第一个轴的标签展示在左侧，最后一个轴的名称需要预留一半宽度
+	firstLabelWidth, _ := seriesPainter.MeasureTextMaxWidthHeight(labelsList[0])
+	left := chart.MaxInt(firstLabelWidth+tickLength+labelMargin, seriesPainter.MeasureText(names[0]).Width()>>1)
+	right := seriesPainter.MeasureText(names[count-1]).Width() >> 1
+	width := seriesPainter.Width() - left - right
+	if width <= 0 {
+		return BoxZero, errors.New("The width of parallel chart is too small")
+	}
+	xValues := autoDivide(width, count-1)
+	for index := range xValues {
+		xValues[index] += left
+	}
+
+	// 坐标轴
+	for index, x := range xValues {
+		seriesPainter.OverrideDrawingStyle(Style{
+			StrokeColor: theme.GetAxisStrokeColor(),
+			StrokeWidth: 1,
+		})
+		seriesPainter.LineStroke([]Point{
+			{
+				X: x,
+				Y: top,
+			},
+			{
+				X: x,
+				Y: top + height,
+			},
+		})
+		name := names[index]
+		b := seriesPainter.MeasureText(name)
+		seriesPainter.Text(name, x-b.Width()>>1, nameHeight)
+
+		labels := labelsList[index]
+		yValues := autoDivide(height, len(labels)-1)
+		for j, label := range labels {
+			y := top + height - yValues[j]
+			seriesPainter.LineStroke([]Point{
+				{
+					X: x - tickLength,
+					Y: y,
+				},
+				{
+					X: x,
+					Y: y,
+				},
+			})
+			b := seriesPainter.MeasureText(label)
+			seriesPainter.Text(label, x-tickLength-labelMargin>>1-b.Width(), y+b.Height()>>1)
+		}
+	}
+
+	strokeWidth := opt.Parallel.StrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = defaultStrokeWidth
+	}
+	opacity := opt.Parallel.Opacity
+	if opacity == 0 {
+		opacity = 255
+	}
+	for _, series := range seriesList {
+		color := theme.GetSeriesColor(series.index)
+		seriesPainter.OverrideDrawingStyle(Style{
+			StrokeColor:     color.WithAlpha(opacity),
+			StrokeWidth:     strokeWidth,
+			StrokeDashArray: series.Style.StrokeDashArray,
+		})
+		points := make([]Point, 0, count)
+		for index, item := range series.Data {
+			if index >= count {
+				break
+			}
+			// 空值则断开
+			if item.Value == nullValue {
+				if len(points) > 1 {
+					seriesPainter.LineStroke(points)
+				}
+				points = points[:0]
+				continue
+			}
+			r := ranges[index]
+			points = append(points, Point{
+				X: xValues[index],
+				Y: top + r.getRestHeight(item.Value),
+			})
+		}
+		if len(points) > 1 {
+			seriesPainter.LineStroke(points)
+		}
+	}
+
+	return pc.p.box, nil
+}
+
+func (pc *parallelChart) Render() (Box, error) {
+	p := pc.p
+	opt := pc.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:      opt.Theme,
+		Padding:    opt.Padding,
+		SeriesList: opt.SeriesList,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption:        opt.Title,
+		LegendOption:       opt.Legend,
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeParallel)
+	return pc.render(renderResult, seriesList)
+}
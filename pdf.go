@@ -0,0 +1,712 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// 贝塞尔曲线近似圆弧的系数
+const pdfArcKappa = 0.5522847498
+
+type pdfFont struct {
+	name   string
+	font   *truetype.Font
+	data   []byte
+	glyphs map[truetype.Index]rune
+}
+
+// pdfRenderer renders chart commands to a single page pdf,
+// the unit of pdf is the same as pixel
+type pdfRenderer struct {
+	width     int
+	height    int
+	dpi       float64
+	s         chart.Style
+	textTheta *float64
+	// 当前路径
+	path    []string
+	hasPath bool
+	x       float64
+	y       float64
+	content bytes.Buffer
+	fonts   []*pdfFont
+	alphas  []uint8
+	// 渐变填充及路径区域
+	fillGradient *Gradient
+	bounds       pathBounds
+	gradients    []*Gradient
+}
+
+// newPDFRenderer returns a new pdf renderer
+func newPDFRenderer(width, height int) (chart.Renderer, error) {
+	r := &pdfRenderer{
+		width:  width,
+		height: height,
+		dpi:    chart.DefaultDPI,
+	}
+	// 翻转坐标系，原点为左上角
+	r.content.WriteString(fmt.Sprintf("1 0 0 -1 0 %d cm\n", height))
+	return r, nil
+}
+
+func formatPDFNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+func formatPDFColor(c drawing.Color) string {
+	return fmt.Sprintf("%s %s %s",
+		formatPDFNumber(float64(c.R)/255),
+		formatPDFNumber(float64(c.G)/255),
+		formatPDFNumber(float64(c.B)/255),
+	)
+}
+
+// getAlphaName returns the name of graphics state for alpha
+func (r *pdfRenderer) getAlphaName(alpha uint8) string {
+	index := -1
+	for i, v := range r.alphas {
+		if v == alpha {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		r.alphas = append(r.alphas, alpha)
+		index = len(r.alphas) - 1
+	}
+	return fmt.Sprintf("GS%d", index+1)
+}
+
+// getGradientIndex returns the index of gradient, the same gradient is only added once
+func (r *pdfRenderer) getGradientIndex(g *Gradient) int {
+	key := g.String()
+	for i, item := range r.gradients {
+		if item.String() == key {
+			return i + 1
+		}
+	}
+	r.gradients = append(r.gradients, g)
+	return len(r.gradients)
+}
+
+// getFont returns the pdf font of truetype font
+func (r *pdfRenderer) getFont(f *truetype.Font) *pdfFont {
+	for _, item := range r.fonts {
+		if item.font == f {
+			return item
+		}
+	}
+	item := &pdfFont{
+		name:   fmt.Sprintf("F%d", len(r.fonts)+1),
+		font:   f,
+		data:   getFontData(f),
+		glyphs: make(map[truetype.Index]rune),
+	}
+	r.fonts = append(r.fonts, item)
+	return item
+}
+
+func (r *pdfRenderer) ResetStyle() {
+	r.s = chart.Style{
+		Font: r.s.Font,
+	}
+	r.fillGradient = nil
+	r.ClearTextRotation()
+}
+
+func (r *pdfRenderer) setFillGradient(g *Gradient) {
+	r.fillGradient = g
+}
+
+func (r *pdfRenderer) GetDPI() float64 {
+	return r.dpi
+}
+
+func (r *pdfRenderer) SetDPI(dpi float64) {
+	r.dpi = dpi
+}
+
+func (r *pdfRenderer) SetClassName(className string) {
+	r.s.ClassName = className
+}
+
+func (r *pdfRenderer) SetStrokeColor(c drawing.Color) {
+	r.s.StrokeColor = c
+}
+
+func (r *pdfRenderer) SetFillColor(c drawing.Color) {
+	r.s.FillColor = c
+}
+
+func (r *pdfRenderer) SetStrokeWidth(width float64) {
+	r.s.StrokeWidth = width
+}
+
+func (r *pdfRenderer) SetStrokeDashArray(dashArray []float64) {
+	r.s.StrokeDashArray = dashArray
+}
+
+func (r *pdfRenderer) moveTo(x, y float64) {
+	r.path = append(r.path, fmt.Sprintf("%s %s m", formatPDFNumber(x), formatPDFNumber(y)))
+	r.bounds.add(x, y)
+	r.hasPath = true
+	r.x = x
+	r.y = y
+}
+
+func (r *pdfRenderer) lineTo(x, y float64) {
+	r.path = append(r.path, fmt.Sprintf("%s %s l", formatPDFNumber(x), formatPDFNumber(y)))
+	r.bounds.add(x, y)
+	r.hasPath = true
+	r.x = x
+	r.y = y
+}
+
+func (r *pdfRenderer) curveTo(x1, y1, x2, y2, x, y float64) {
+	r.path = append(r.path, fmt.Sprintf("%s %s %s %s %s %s c",
+		formatPDFNumber(x1),
+		formatPDFNumber(y1),
+		formatPDFNumber(x2),
+		formatPDFNumber(y2),
+		formatPDFNumber(x),
+		formatPDFNumber(y),
+	))
+	r.x = x
+	r.y = y
+}
+
+func (r *pdfRenderer) MoveTo(x, y int) {
+	r.moveTo(float64(x), float64(y))
+}
+
+func (r *pdfRenderer) LineTo(x, y int) {
+	r.lineTo(float64(x), float64(y))
+}
+
+// QuadCurveTo converts the quad curve to cubic curve
+func (r *pdfRenderer) QuadCurveTo(cx, cy, x, y int) {
+	fcx := float64(cx)
+	fcy := float64(cy)
+	fx := float64(x)
+	fy := float64(y)
+	r.bounds.addQuad(fcx, fcy, fx, fy)
+	r.curveTo(
+		r.x+2.0/3.0*(fcx-r.x),
+		r.y+2.0/3.0*(fcy-r.y),
+		fx+2.0/3.0*(fcx-fx),
+		fy+2.0/3.0*(fcy-fy),
+		fx,
+		fy,
+	)
+}
+
+// ArcTo draws the arc with cubic curves, the angle is the same as raster renderer
+func (r *pdfRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	fcx := float64(cx)
+	fcy := float64(cy)
+	startX := fcx + math.Cos(startAngle)*rx
+	startY := fcy + math.Sin(startAngle)*ry
+	if r.hasPath {
+		r.lineTo(startX, startY)
+	} else {
+		r.moveTo(startX, startY)
+	}
+	r.bounds.addArc(fcx, fcy, rx, ry, startAngle, delta)
+	// 每段不超过90度
+	count := int(math.Ceil(math.Abs(delta) / (math.Pi / 2)))
+	if count == 0 {
+		return
+	}
+	step := delta / float64(count)
+	k := 4.0 / 3.0 * math.Tan(step/4)
+	angle := startAngle
+	for i := 0; i < count; i++ {
+		next := angle + step
+		cos0 := math.Cos(angle)
+		sin0 := math.Sin(angle)
+		cos1 := math.Cos(next)
+		sin1 := math.Sin(next)
+		r.curveTo(
+			fcx+rx*(cos0-k*sin0),
+			fcy+ry*(sin0+k*cos0),
+			fcx+rx*(cos1+k*sin1),
+			fcy+ry*(sin1-k*cos1),
+			fcx+rx*cos1,
+			fcy+ry*sin1,
+		)
+		angle = next
+	}
+}
+
+func (r *pdfRenderer) Close() {
+	r.path = append(r.path, "h")
+}
+
+// drawPath paints the current path and clears it
+func (r *pdfRenderer) drawPath(fill, stroke bool) {
+	if len(r.path) == 0 {
+		return
+	}
+	s := r.s
+	fill = fill && !s.FillColor.IsZero()
+	stroke = stroke && !s.StrokeColor.IsZero() && s.StrokeWidth > 0
+	path := strings.Join(r.path, "\n")
+	bounds := r.bounds
+	r.path = nil
+	r.hasPath = false
+	r.bounds.reset()
+	if !fill && !stroke {
+		return
+	}
+	buf := &r.content
+	width := bounds.maxX - bounds.minX
+	height := bounds.maxY - bounds.minY
+	if fill && r.fillGradient != nil && width > 0 && height > 0 {
+		// 以路径裁剪，并将渐变的单位坐标映射至路径区域
+		index := r.getGradientIndex(r.fillGradient)
+		buf.WriteString("q\n" + path + "\nW n\n")
+		buf.WriteString(fmt.Sprintf("%s 0 0 %s %s %s cm\n",
+			formatPDFNumber(width),
+			formatPDFNumber(height),
+			formatPDFNumber(bounds.minX),
+			formatPDFNumber(bounds.minY),
+		))
+		if r.fillGradient.hasAlpha() {
+			buf.WriteString(fmt.Sprintf("/SM%d gs\n", index))
+		}
+		buf.WriteString(fmt.Sprintf("/Sh%d sh\nQ\n", index))
+		fill = false
+		if !stroke {
+			return
+		}
+	}
+	buf.WriteString("q\n")
+	if fill {
+		buf.WriteString(formatPDFColor(s.FillColor) + " rg\n")
+		if s.FillColor.A != 255 {
+			buf.WriteString("/" + r.getAlphaName(s.FillColor.A) + " gs\n")
+		}
+	}
+	if stroke {
+		buf.WriteString(formatPDFColor(s.StrokeColor) + " RG\n")
+		buf.WriteString(formatPDFNumber(s.StrokeWidth) + " w\n")
+		// 填充与描边透明度不一致时，以描边为准
+		if s.StrokeColor.A != 255 && (!fill || s.StrokeColor.A != s.FillColor.A) {
+			buf.WriteString("/" + r.getAlphaName(s.StrokeColor.A) + " gs\n")
+		}
+		if len(s.StrokeDashArray) != 0 {
+			values := make([]string, len(s.StrokeDashArray))
+			for index, v := range s.StrokeDashArray {
+				values[index] = formatPDFNumber(v)
+			}
+			buf.WriteString("[" + strings.Join(values, " ") + "] 0 d\n")
+		}
+	}
+	buf.WriteString(path + "\n")
+	op := "S"
+	if fill && stroke {
+		op = "B"
+	} else if fill {
+		op = "f"
+	}
+	buf.WriteString(op + "\nQ\n")
+}
+
+func (r *pdfRenderer) Stroke() {
+	r.drawPath(false, true)
+}
+
+func (r *pdfRenderer) Fill() {
+	r.drawPath(true, false)
+}
+
+func (r *pdfRenderer) FillStroke() {
+	r.drawPath(true, true)
+}
+
+// Circle adds a circle to the path but does not apply the fill or stroke
+func (r *pdfRenderer) Circle(radius float64, x, y int) {
+	fx := float64(x)
+	fy := float64(y)
+	k := radius * pdfArcKappa
+	r.moveTo(fx-radius, fy)
+	r.bounds.add(fx-radius, fy-radius)
+	r.bounds.add(fx+radius, fy+radius)
+	r.curveTo(fx-radius, fy-k, fx-k, fy-radius, fx, fy-radius)
+	r.curveTo(fx+k, fy-radius, fx+radius, fy-k, fx+radius, fy)
+	r.curveTo(fx+radius, fy+k, fx+k, fy+radius, fx, fy+radius)
+	r.curveTo(fx-k, fy+radius, fx-radius, fy+k, fx-radius, fy)
+}
+
+func (r *pdfRenderer) SetFont(f *truetype.Font) {
+	r.s.Font = f
+}
+
+func (r *pdfRenderer) SetFontColor(c drawing.Color) {
+	r.s.FontColor = c
+}
+
+func (r *pdfRenderer) SetFontSize(size float64) {
+	r.s.FontSize = size
+}
+
+// Text draws the text with embedded font, the font of pdf standard
+// is used if the data of font is not found
+func (r *pdfRenderer) Text(body string, x, y int) {
+	f := r.s.GetFont()
+	if body == "" || f == nil || r.s.FontColor.IsZero() {
+		return
+	}
+	item := r.getFont(f)
+	var text string
+	if len(item.data) != 0 {
+		buf := strings.Builder{}
+		buf.WriteString("<")
+		for _, c := range body {
+			index := f.Index(c)
+			item.glyphs[index] = c
+			buf.WriteString(fmt.Sprintf("%04X", uint16(index)))
+		}
+		buf.WriteString(">")
+		text = buf.String()
+	} else {
+		text = "(" + escapePDFString(body) + ")"
+	}
+	cos := 1.0
+	sin := 0.0
+	if r.textTheta != nil {
+		cos = math.Cos(*r.textTheta)
+		sin = math.Sin(*r.textTheta)
+	}
+	buf := &r.content
+	buf.WriteString("q\n")
+	if r.s.FontColor.A != 255 {
+		buf.WriteString("/" + r.getAlphaName(r.s.FontColor.A) + " gs\n")
+	}
+	buf.WriteString("BT\n")
+	buf.WriteString(fmt.Sprintf("/%s %s Tf\n", item.name, formatPDFNumber(drawing.PointsToPixels(r.dpi, r.s.FontSize))))
+	buf.WriteString(formatPDFColor(r.s.FontColor) + " rg\n")
+	// 文本坐标系需再次翻转
+	buf.WriteString(fmt.Sprintf("%s %s %s %s %d %d Tm\n",
+		formatPDFNumber(cos),
+		formatPDFNumber(sin),
+		formatPDFNumber(sin),
+		formatPDFNumber(-cos),
+		x,
+		y,
+	))
+	buf.WriteString(text + " Tj\nET\nQ\n")
+}
+
+// MeasureText measures the text as the svg renderer
+func (r *pdfRenderer) MeasureText(body string) chart.Box {
+	var box chart.Box
+	f := r.s.GetFont()
+	if f == nil {
+		return box
+	}
+	drawer := &font.Drawer{
+		Face: truetype.NewFace(f, &truetype.Options{
+			DPI:  r.dpi,
+			Size: r.s.FontSize,
+		}),
+	}
+	box.Right = drawer.MeasureString(body).Ceil()
+	box.Bottom = int(drawing.PointsToPixels(r.dpi, r.s.FontSize))
+	if r.textTheta == nil {
+		return box
+	}
+	return box.Corners().Rotate(chart.RadiansToDegrees(*r.textTheta)).Box()
+}
+
+func (r *pdfRenderer) SetTextRotation(radians float64) {
+	r.textTheta = &radians
+}
+
+func (r *pdfRenderer) ClearTextRotation() {
+	r.textTheta = nil
+}
+
+func escapePDFString(value string) string {
+	buf := strings.Builder{}
+	for _, c := range value {
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			buf.WriteRune('\\')
+			buf.WriteRune(c)
+		case c < 32 || c > 126:
+			// 标准字体仅支持ascii
+			buf.WriteRune('?')
+		default:
+			buf.WriteRune(c)
+		}
+	}
+	return buf.String()
+}
+
+// getPDFShading returns the shading dictionary of gradient in unit coordinates,
+// the shading of alpha is returned as gray if alpha is true
+func getPDFShading(g *Gradient, alpha bool) string {
+	getValues := func(c Color) string {
+		if alpha {
+			return formatPDFNumber(float64(c.A) / 255)
+		}
+		return formatPDFColor(c)
+	}
+	stops := g.getStops()
+	functions := make([]string, 0, len(stops))
+	bounds := make([]string, 0, len(stops))
+	encode := make([]string, 0, len(stops))
+	for i := 1; i < len(stops); i++ {
+		prev := stops[i-1]
+		next := stops[i]
+		// 忽略长度为0的区间
+		if next.Offset <= prev.Offset && !(i == len(stops)-1 && len(functions) == 0) {
+			continue
+		}
+		if len(functions) != 0 {
+			bounds = append(bounds, formatPDFNumber(prev.Offset))
+		}
+		functions = append(functions, fmt.Sprintf("<< /FunctionType 2 /Domain [0 1] /C0 [%s] /C1 [%s] /N 1 >>", getValues(prev.Color), getValues(next.Color)))
+		encode = append(encode, "0 1")
+	}
+	function := functions[0]
+	if len(functions) > 1 {
+		function = fmt.Sprintf("<< /FunctionType 3 /Domain [0 1] /Functions [%s] /Bounds [%s] /Encode [%s] >>",
+			strings.Join(functions, " "),
+			strings.Join(bounds, " "),
+			strings.Join(encode, " "),
+		)
+	}
+	colorSpace := "/DeviceRGB"
+	if alpha {
+		colorSpace = "/DeviceGray"
+	}
+	if g.IsRadial() {
+		return fmt.Sprintf("<< /ShadingType 3 /ColorSpace %s /Coords [%s %s 0 %s %s %s] /Function %s /Extend [true true] >>",
+			colorSpace,
+			formatPDFNumber(g.X),
+			formatPDFNumber(g.Y),
+			formatPDFNumber(g.X),
+			formatPDFNumber(g.Y),
+			formatPDFNumber(g.R),
+			function,
+		)
+	}
+	return fmt.Sprintf("<< /ShadingType 2 /ColorSpace %s /Coords [%s %s %s %s] /Function %s /Extend [true true] >>",
+		colorSpace,
+		formatPDFNumber(g.X),
+		formatPDFNumber(g.Y),
+		formatPDFNumber(g.X2),
+		formatPDFNumber(g.Y2),
+		function,
+	)
+}
+
+func compressPDFStream(data []byte) []byte {
+	buf := bytes.Buffer{}
+	w := zlib.NewWriter(&buf)
+	_, _ = w.Write(data)
+	_ = w.Close()
+	return buf.Bytes()
+}
+
+type pdfWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+// nextID returns the id of next object
+func (w *pdfWriter) nextID() int {
+	return len(w.offsets) + 1
+}
+
+// add adds an object and returns the id of it
+func (w *pdfWriter) add(value string) int {
+	w.offsets = append(w.offsets, w.buf.Len())
+	id := len(w.offsets)
+	w.buf.WriteString(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", id, value))
+	return id
+}
+
+// addStream adds a compressed stream object and returns the id of it
+func (w *pdfWriter) addStream(dict string, data []byte) int {
+	data = compressPDFStream(data)
+	w.offsets = append(w.offsets, w.buf.Len())
+	id := len(w.offsets)
+	w.buf.WriteString(fmt.Sprintf("%d 0 obj\n<< %s /Filter /FlateDecode /Length %d >>\nstream\n", id, dict, len(data)))
+	w.buf.Write(data)
+	w.buf.WriteString("\nendstream\nendobj\n")
+	return id
+}
+
+// addFont adds the objects of font and returns the id of font
+func (w *pdfWriter) addFont(item *pdfFont) int {
+	if len(item.data) == 0 {
+		return w.add("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	}
+	f := item.font
+	unitsPerEm := f.FUnitsPerEm()
+	scale := fixed.Int26_6(unitsPerEm)
+	toPDFUnit := func(v fixed.Int26_6) int {
+		return int(v) * 1000 / int(unitsPerEm)
+	}
+	name := f.Name(truetype.NameIDPostscriptName)
+	name = strings.Map(func(r rune) rune {
+		if r <= 32 || r > 126 || strings.ContainsRune("()<>[]{}/%#", r) {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "" {
+		name = item.name
+	}
+	bounds := f.Bounds(scale)
+
+	fontFileID := w.addStream(fmt.Sprintf("/Length1 %d", len(item.data)), item.data)
+	descriptorID := w.add(fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 32 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+		name,
+		toPDFUnit(bounds.Min.X),
+		toPDFUnit(bounds.Min.Y),
+		toPDFUnit(bounds.Max.X),
+		toPDFUnit(bounds.Max.Y),
+		toPDFUnit(bounds.Max.Y),
+		toPDFUnit(bounds.Min.Y),
+		toPDFUnit(bounds.Max.Y),
+		fontFileID,
+	))
+
+	indexes := make([]int, 0, len(item.glyphs))
+	for index := range item.glyphs {
+		indexes = append(indexes, int(index))
+	}
+	sort.Ints(indexes)
+	widths := strings.Builder{}
+	toUnicode := strings.Builder{}
+	toUnicode.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n")
+	toUnicode.WriteString("/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n")
+	toUnicode.WriteString("/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n")
+	toUnicode.WriteString("1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	for i, index := range indexes {
+		// 每段最多100个
+		if i%100 == 0 {
+			if i != 0 {
+				toUnicode.WriteString("endbfchar\n")
+			}
+			toUnicode.WriteString(fmt.Sprintf("%d beginbfchar\n", chart.MinInt(100, len(indexes)-i)))
+		}
+		c := item.glyphs[truetype.Index(index)]
+		utf16 := ""
+		if c > 0xFFFF {
+			c -= 0x10000
+			utf16 = fmt.Sprintf("%04X%04X", 0xD800+(c>>10), 0xDC00+(c&0x3FF))
+		} else {
+			utf16 = fmt.Sprintf("%04X", c)
+		}
+		toUnicode.WriteString(fmt.Sprintf("<%04X> <%s>\n", index, utf16))
+		advance := f.HMetric(scale, truetype.Index(index)).AdvanceWidth
+		widths.WriteString(fmt.Sprintf("%d [%d] ", index, toPDFUnit(advance)))
+	}
+	if len(indexes) != 0 {
+		toUnicode.WriteString("endbfchar\n")
+	}
+	toUnicode.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend")
+	toUnicodeID := w.addStream("", []byte(toUnicode.String()))
+
+	cidFontID := w.add(fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R /CIDToGIDMap /Identity /W [%s] >>",
+		name,
+		descriptorID,
+		strings.TrimSpace(widths.String()),
+	))
+	return w.add(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+		name,
+		cidFontID,
+		toUnicodeID,
+	))
+}
+
+// Save writes the pdf to the writer
+func (r *pdfRenderer) Save(writer io.Writer) error {
+	w := &pdfWriter{}
+	w.buf.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+
+	fonts := make([]string, len(r.fonts))
+	for index, item := range r.fonts {
+		fonts[index] = fmt.Sprintf("/%s %d 0 R", item.name, w.addFont(item))
+	}
+	alphas := make([]string, len(r.alphas))
+	for index, alpha := range r.alphas {
+		value := formatPDFNumber(float64(alpha) / 255)
+		alphas[index] = fmt.Sprintf("/GS%d << /Type /ExtGState /ca %s /CA %s >>", index+1, value, value)
+	}
+	shadings := make([]string, len(r.gradients))
+	for index, g := range r.gradients {
+		name := index + 1
+		shadings[index] = fmt.Sprintf("/Sh%d %d 0 R", name, w.add(getPDFShading(g, false)))
+		if !g.hasAlpha() {
+			continue
+		}
+		// 透明度以灰度渐变作为软蒙版
+		maskID := w.add(getPDFShading(g, true))
+		formID := w.addStream(fmt.Sprintf("/Type /XObject /Subtype /Form /BBox [0 0 1 1] /Group << /S /Transparency /CS /DeviceGray >> /Resources << /Shading << /Sh0 %d 0 R >> >>", maskID), []byte("/Sh0 sh"))
+		alphas = append(alphas, fmt.Sprintf("/SM%d << /Type /ExtGState /SMask << /Type /Mask /S /Luminosity /G %d 0 R >> >>", name, formID))
+	}
+	contentID := w.addStream("", r.content.Bytes())
+
+	// 页面集合与页面相互引用
+	pagesID := w.nextID() + 1
+	pageID := w.add(fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Contents %d 0 R /Resources << /Font << %s >> /ExtGState << %s >> /Shading << %s >> >> >>",
+		pagesID,
+		r.width,
+		r.height,
+		contentID,
+		strings.Join(fonts, " "),
+		strings.Join(alphas, " "),
+		strings.Join(shadings, " "),
+	))
+	w.add(fmt.Sprintf("<< /Type /Pages /Kids [%d 0 R] /Count 1 >>", pageID))
+	catalogID := w.add(fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesID))
+
+	xrefOffset := w.buf.Len()
+	w.buf.WriteString(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(w.offsets)+1))
+	for _, offset := range w.offsets {
+		w.buf.WriteString(fmt.Sprintf("%010d 00000 n \n", offset))
+	}
+	w.buf.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(w.offsets)+1, catalogID, xrefOffset))
+	_, err := writer.Write(w.buf.Bytes())
+	return err
+}
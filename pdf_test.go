@@ -0,0 +1,99 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func TestFormatPDFNumber(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("1", formatPDFNumber(1))
+	assert.Equal("1.23", formatPDFNumber(1.234))
+	assert.Equal("-0.5", formatPDFNumber(-0.5))
+	assert.Equal("1 0.5 0", formatPDFColor(drawing.Color{
+		R: 255,
+		G: 128,
+		B: 0,
+		A: 255,
+	}))
+}
+
+func TestEscapePDFString(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(`a\(b\)\\c`, escapePDFString(`a(b)\c`))
+	assert.Equal("a?b", escapePDFString("a中b"))
+}
+
+func TestPDFRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputPDF,
+		Width:  400,
+		Height: 300,
+	}, PainterThemeOption(defaultTheme))
+	assert.Nil(err)
+	p.SetDrawingStyle(Style{
+		StrokeColor: drawing.ColorBlack,
+		StrokeWidth: 1,
+		FillColor: drawing.Color{
+			R: 255,
+			A: 128,
+		},
+	})
+	p.MoveTo(0, 0).LineTo(100, 100).Stroke()
+	p.ArcTo(200, 150, 50, 50, 0, math.Pi).Close().FillStroke()
+	font, _ := GetDefaultFont()
+	p.SetTextStyle(Style{
+		Font:      font,
+		FontSize:  12,
+		FontColor: drawing.ColorBlack,
+	})
+	p.Text("Hello", 10, 20)
+
+	r, ok := p.render.(*pdfRenderer)
+	assert.True(ok)
+	content := r.content.String()
+	assert.True(strings.HasPrefix(content, "1 0 0 -1 0 300 cm\n"))
+	assert.Contains(content, "0 0 m\n100 100 l\n")
+	assert.Contains(content, " c\n")
+	assert.Contains(content, "/GS1 gs")
+	assert.Contains(content, "Tj")
+
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	data := string(buf)
+	assert.True(strings.HasPrefix(data, "%PDF-1.4\n"))
+	assert.True(strings.HasSuffix(data, "%%EOF\n"))
+	assert.Contains(data, "/MediaBox [0 0 400 300]")
+	assert.Contains(data, "/FontFile2")
+	assert.Contains(data, "/ExtGState << /GS1 << /Type /ExtGState /ca 0.5 /CA 0.5 >> >>")
+}
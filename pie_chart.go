@@ -228,8 +228,28 @@ func (p *pieChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 			StrokeColor: s.color,
 			FillColor:   s.color,
 		})
+		seriesPainter.startSeriesGroup(s.series)
+		seriesPainter.groupTooltip(seriesPainter.formatTooltip(s.series, "", s.value))
 		seriesPainter.MoveTo(s.cx, s.cy)
+		fillGradient := s.series.FillGradient
+		if len(s.series.Data) != 0 && s.series.Data[0].FillGradient != nil {
+			fillGradient = s.series.Data[0].FillGradient
+		}
+		seriesPainter.SetFillGradient(fillGradient)
 		seriesPainter.ArcTo(s.cx, s.cy, s.rx, s.ry, s.start, s.delta).LineTo(s.cx, s.cy).Close().FillStroke()
+		seriesPainter.SetFillGradient(nil)
+		seriesPainter.endGroup()
+		sectorPoints := newSectorPoints(s.cx, s.cy, s.rx, s.start, s.delta)
+		seriesPainter.addRegion(Region{
+			Type:        RegionTypeSector,
+			Shape:       RegionShapePoly,
+			SeriesIndex: s.series.index,
+			DataIndex:   0,
+			Name:        getAccessibleName(s.series),
+			Value:       s.value,
+			Box:         getPointsBox(sectorPoints),
+			Points:      sectorPoints,
+		})
 		if !s.showLabel {
 			continue
 		}
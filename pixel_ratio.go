@@ -0,0 +1,122 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// pixelRatioRenderer scales the drawing of logical pixels to device pixels,
+// the painter keeps using the logical size and the text is measured as logical size
+type pixelRatioRenderer struct {
+	chart.Renderer
+	ratio float64
+}
+
+// newPixelRatioRenderer returns a renderer which size is scaled by ratio
+func newPixelRatioRenderer(width, height int, ratio float64) (chart.Renderer, error) {
+	r, err := newRasterRenderer(
+		int(math.Ceil(float64(width)*ratio)),
+		int(math.Ceil(float64(height)*ratio)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pixelRatioRenderer{
+		Renderer: r,
+		ratio:    ratio,
+	}, nil
+}
+
+func (r *pixelRatioRenderer) scale(value int) int {
+	return int(math.Round(float64(value) * r.ratio))
+}
+
+func (r *pixelRatioRenderer) SetStrokeWidth(width float64) {
+	r.Renderer.SetStrokeWidth(width * r.ratio)
+}
+
+func (r *pixelRatioRenderer) SetStrokeDashArray(dashArray []float64) {
+	if len(dashArray) == 0 {
+		r.Renderer.SetStrokeDashArray(dashArray)
+		return
+	}
+	values := make([]float64, len(dashArray))
+	for index, value := range dashArray {
+		values[index] = value * r.ratio
+	}
+	r.Renderer.SetStrokeDashArray(values)
+}
+
+func (r *pixelRatioRenderer) MoveTo(x, y int) {
+	r.Renderer.MoveTo(r.scale(x), r.scale(y))
+}
+
+func (r *pixelRatioRenderer) LineTo(x, y int) {
+	r.Renderer.LineTo(r.scale(x), r.scale(y))
+}
+
+func (r *pixelRatioRenderer) QuadCurveTo(cx, cy, x, y int) {
+	r.Renderer.QuadCurveTo(r.scale(cx), r.scale(cy), r.scale(x), r.scale(y))
+}
+
+func (r *pixelRatioRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	r.Renderer.ArcTo(r.scale(cx), r.scale(cy), rx*r.ratio, ry*r.ratio, startAngle, delta)
+}
+
+func (r *pixelRatioRenderer) Circle(radius float64, x, y int) {
+	r.Renderer.Circle(radius*r.ratio, r.scale(x), r.scale(y))
+}
+
+func (r *pixelRatioRenderer) SetFontSize(size float64) {
+	r.Renderer.SetFontSize(size * r.ratio)
+}
+
+func (r *pixelRatioRenderer) Text(body string, x, y int) {
+	r.Renderer.Text(body, r.scale(x), r.scale(y))
+}
+
+// MeasureText returns the logical size of text
+func (r *pixelRatioRenderer) MeasureText(body string) chart.Box {
+	box := r.Renderer.MeasureText(body)
+	return chart.Box{
+		Top:    int(math.Floor(float64(box.Top) / r.ratio)),
+		Left:   int(math.Floor(float64(box.Left) / r.ratio)),
+		Right:  int(math.Ceil(float64(box.Right) / r.ratio)),
+		Bottom: int(math.Ceil(float64(box.Bottom) / r.ratio)),
+	}
+}
+
+func (r *pixelRatioRenderer) release() {
+	if rr, ok := r.Renderer.(releaser); ok {
+		rr.release()
+	}
+}
+
+func (r *pixelRatioRenderer) setFillGradient(g *Gradient) {
+	if gr, ok := r.Renderer.(gradientRenderer); ok {
+		gr.setFillGradient(g)
+	}
+}
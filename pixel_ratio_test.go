@@ -0,0 +1,107 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func TestPixelRatioRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	newPainter := func(outputType string, ratio float64) *Painter {
+		p, err := NewPainter(PainterOptions{
+			Type:             outputType,
+			Width:            60,
+			Height:           40,
+			DevicePixelRatio: ratio,
+		})
+		assert.Nil(err)
+		return p
+	}
+
+	p := newPainter(ChartOutputPNG, 2)
+	assert.Equal(60, p.Width())
+	assert.Equal(40, p.Height())
+	p.SetDrawingStyle(Style{
+		FillColor: drawing.ColorRed,
+	})
+	p.Rect(Box{
+		Left:   10,
+		Top:    10,
+		Right:  20,
+		Bottom: 20,
+	})
+	img, err := p.Image()
+	assert.Nil(err)
+	assert.Equal(image.Rect(0, 0, 120, 80), img.Bounds())
+	red := color.RGBA{
+		R: 255,
+		A: 255,
+	}
+	assert.Equal(red, img.At(25, 25))
+	assert.Equal(red, img.At(38, 38))
+	assert.NotEqual(red, img.At(15, 15))
+	assert.NotEqual(red, img.At(42, 42))
+
+	// 文本尺寸为逻辑尺寸
+	style := Style{
+		FontSize:  12,
+		FontColor: drawing.ColorBlack,
+	}
+	p.SetTextStyle(style)
+	box := p.MeasureText("Hello World!")
+	basic := newPainter(ChartOutputPNG, 0)
+	basic.SetTextStyle(style)
+	expected := basic.MeasureText("Hello World!")
+	assert.InDelta(expected.Width(), box.Width(), 1)
+	assert.InDelta(expected.Height(), box.Height(), 1)
+
+	// svg忽略pixel ratio
+	buf, err := newPainter(ChartOutputSVG, 2).Bytes()
+	assert.Nil(err)
+	assert.True(strings.Contains(string(buf), `width="60" height="40"`))
+}
+
+func TestChartPixelRatio(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := LineRender([][]float64{
+		{120, 132, 101, 134, 90, 230, 210},
+	},
+		PNGTypeOption(),
+		PixelRatioOptionFunc(2),
+		XAxisDataOptionFunc([]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}),
+	)
+	assert.Nil(err)
+	img, err := p.Image()
+	assert.Nil(err)
+	assert.Equal(image.Rect(0, 0, 1200, 800), img.Bounds())
+	assert.Equal(600, p.Width())
+}
@@ -0,0 +1,274 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+const (
+	PolarShapePolygon = "polygon"
+	PolarShapeCircle  = "circle"
+)
+
+const defaultPolarSplitNumber = 5
+
+type PolarOption struct {
+	// The radius of polar, e.g.: 40%, default is "40%"
+	Radius string
+	// The start angle of angle axis in degrees, default is 90 (the top side)
+	StartAngle *float64
+	// The label of angle axis, the data of x axis will be used if it is nil
+	Data []string
+	// Number of segments that the radius axis is split into, default is 5
+	SplitNumber int
+	// The maximum value of radius axis
+	Max *float64
+	// Close the line of polar line series
+	LineClosed bool
+	// The flag for show radius axis label, set this to *false will hide it
+	RadiusLabelShow *bool
+}
+
+type polarCoordinate struct {
+	center Point
+	radius float64
+	angles []float64
+}
+
+// getPolarAngles returns the angle of each category,
+// the first category starts from the start angle and goes clockwise
+func getPolarAngles(count int, startAngle float64) []float64 {
+	angles := make([]float64, count)
+	for i := 0; i < count; i++ {
+		angles[i] = 2*math.Pi/float64(count)*float64(i) + startAngle
+	}
+	return angles
+}
+
+// getPolarStartAngle converts the start angle(degrees, anticlockwise from 3 o'clock)
+// to radians of painter
+func getPolarStartAngle(degrees *float64) float64 {
+	if degrees == nil || *degrees == 90 {
+		return -math.Pi / 2
+	}
+	return -chart.DegreesToRadians(*degrees)
+}
+
+func newPolarCoordinate(center Point, radius float64, count int, startAngle float64) polarCoordinate {
+	return polarCoordinate{
+		center: center,
+		radius: radius,
+		angles: getPolarAngles(count, startAngle),
+	}
+}
+
+// Count returns the count of angle axis categories
+func (pc *polarCoordinate) Count() int {
+	return len(pc.angles)
+}
+
+// BandAngle returns the angle of each category
+func (pc *polarCoordinate) BandAngle() float64 {
+	if len(pc.angles) == 0 {
+		return 0
+	}
+	return 2 * math.Pi / float64(len(pc.angles))
+}
+
+// Angle returns the angle of category
+func (pc *polarCoordinate) Angle(index int) float64 {
+	return pc.angles[index%len(pc.angles)]
+}
+
+// Point returns the point of category with radius
+func (pc *polarCoordinate) Point(index int, radius float64) Point {
+	return getPolygonPoint(pc.center, radius, pc.Angle(index))
+}
+
+// Points returns the points of all categories with radius
+func (pc *polarCoordinate) Points(radius float64) []Point {
+	points := make([]Point, len(pc.angles))
+	for index, angle := range pc.angles {
+		points[index] = getPolygonPoint(pc.center, radius, angle)
+	}
+	return points
+}
+
+type polarAxisPainter struct {
+	p          *Painter
+	coordinate polarCoordinate
+	opt        *PolarAxisOption
+}
+
+type PolarAxisOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font of label
+	Font *truetype.Font
+	// The shape of split line, it can be "polygon" or "circle"
+	Shape string
+	// The label of angle axis
+	Data []string
+	// The label of radius axis
+	RadiusData []string
+	// Number of segments that the radius axis is split into
+	SplitNumber int
+}
+
+// NewPolarAxisPainter returns a polar axis renderer
+func NewPolarAxisPainter(p *Painter, coordinate polarCoordinate, opt PolarAxisOption) *polarAxisPainter {
+	return &polarAxisPainter{
+		p:          p,
+		coordinate: coordinate,
+		opt:        &opt,
+	}
+}
+
+func (pa *polarAxisPainter) circle(radius float64) {
+	p := pa.p
+	center := pa.coordinate.center
+	// 两段半圆弧，避免起止点相同时svg不绘制
+	p.ArcTo(center.X, center.Y, radius, radius, 0, math.Pi)
+	p.ArcTo(center.X, center.Y, radius, radius, math.Pi, math.Pi)
+	p.Stroke()
+}
+
+func (pa *polarAxisPainter) polygon(radius float64) {
+	p := pa.p
+	points := pa.coordinate.Points(radius)
+	for i, item := range points {
+		if i == 0 {
+			p.MoveTo(item.X, item.Y)
+		} else {
+			p.LineTo(item.X, item.Y)
+		}
+	}
+	p.LineTo(points[0].X, points[0].Y)
+	p.Stroke()
+}
+
+func (pa *polarAxisPainter) Render() (Box, error) {
+	p := pa.p
+	p.startComponent("axis polar-axis")
+	defer p.endComponent()
+	opt := pa.opt
+	coordinate := pa.coordinate
+	theme := opt.Theme
+	if theme == nil {
+		theme = p.theme
+	}
+	if coordinate.Count() == 0 {
+		return BoxZero, nil
+	}
+	splitNumber := opt.SplitNumber
+	if splitNumber <= 0 {
+		splitNumber = defaultPolarSplitNumber
+	}
+	center := coordinate.center
+	divideRadius := coordinate.radius / float64(splitNumber)
+
+	p.OverrideDrawingStyle(Style{
+		StrokeColor: theme.GetAxisSplitLineColor(),
+		StrokeWidth: 1,
+	})
+	for i := 0; i < splitNumber; i++ {
+		r := divideRadius * float64(i+1)
+		if opt.Shape == PolarShapeCircle {
+			pa.circle(r)
+		} else {
+			pa.polygon(r)
+		}
+	}
+	points := coordinate.Points(coordinate.radius)
+	for _, item := range points {
+		p.MoveTo(center.X, center.Y)
+		p.LineTo(item.X, item.Y)
+		p.Stroke()
+	}
+	p.OverrideTextStyle(Style{
+		FontColor: theme.GetTextColor(),
+		FontSize:  labelFontSize,
+		Font:      opt.Font,
+	})
+	offset := 5
+	// 角度轴文本
+	for index, item := range points {
+		if index >= len(opt.Data) {
+			break
+		}
+		name := opt.Data[index]
+		b := p.MeasureText(name)
+		isXCenter := item.X == center.X
+		isYCenter := item.Y == center.Y
+		isRight := item.X > center.X
+		isLeft := item.X < center.X
+		isTop := item.Y < center.Y
+		isBottom := item.Y > center.Y
+		x := item.X
+		y := item.Y
+		if isXCenter {
+			x -= b.Width() >> 1
+			if isTop {
+				y -= b.Height()
+			} else {
+				y += b.Height()
+			}
+		}
+		if isYCenter {
+			y += b.Height() >> 1
+		}
+		if isTop {
+			y += offset
+		}
+		if isBottom {
+			y += offset
+		}
+		if isRight {
+			x += offset
+		}
+		if isLeft {
+			x -= (b.Width() + offset)
+		}
+		p.Text(name, x, y)
+	}
+	// 半径轴文本，沿第一个角度展示
+	for index, text := range opt.RadiusData {
+		if index == 0 || index > splitNumber {
+			continue
+		}
+		item := coordinate.Point(0, divideRadius*float64(index))
+		b := p.MeasureText(text)
+		p.Text(text, item.X+offset, item.Y+b.Height()>>1)
+	}
+
+	return Box{
+		Left:   center.X - int(coordinate.radius),
+		Top:    center.Y - int(coordinate.radius),
+		Right:  center.X + int(coordinate.radius),
+		Bottom: center.Y + int(coordinate.radius),
+	}, nil
+}
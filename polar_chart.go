@@ -0,0 +1,333 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+type polarChart struct {
+	p   *Painter
+	opt *PolarChartOption
+}
+
+type PolarChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The padding of polar chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The polar option
+	Polar PolarOption
+	// The flag for show symbol of line, set this to *false will hide symbol
+	SymbolShow *bool
+	// The stroke width of line
+	StrokeWidth float64
+	// Fill the area of closed line
+	FillArea bool
+	// background fill (alpha) opacity
+	Opacity uint8
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewPolarChart returns a polar chart renderer
+func NewPolarChart(p *Painter, opt PolarChartOption) *polarChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &polarChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+func (pc *polarChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	opt := pc.opt
+	polar := opt.Polar
+	count := len(polar.Data)
+	for _, series := range seriesList {
+		count = chart.MaxInt(count, len(series.Data))
+	}
+	if count == 0 {
+		return BoxZero, errors.New("The data of polar chart can not be nil")
+	}
+	seriesPainter := result.seriesPainter
+	theme := opt.Theme
+
+	cx := seriesPainter.Width() >> 1
+	cy := seriesPainter.Height() >> 1
+	diameter := chart.MinInt(seriesPainter.Width(), seriesPainter.Height())
+	radius := getRadius(float64(diameter), polar.Radius)
+
+	splitNumber := polar.SplitNumber
+	if splitNumber <= 0 {
+		splitNumber = defaultPolarSplitNumber
+	}
+	divideRadius := float64(int(radius / float64(splitNumber)))
+	radius = divideRadius * float64(splitNumber)
+
+	min := math.MaxFloat64
+	max := -math.MaxFloat64
+	for _, series := range seriesList {
+		for _, item := range series.Data {
+			if item.Value == nullValue {
+				continue
+			}
+			min = math.Min(min, item.Value)
+			max = math.Max(max, item.Value)
+		}
+	}
+	// 半径轴从0开始
+	if min > 0 {
+		min = 0
+	}
+	r := NewRange(AxisRangeOption{
+		Painter:     seriesPainter,
+		Min:         min,
+		Max:         max,
+		Size:        int(radius),
+		DivideCount: splitNumber,
+	})
+	if polar.Max != nil && *polar.Max >= max {
+		r.max = *polar.Max
+	}
+
+	center := Point{
+		X: cx,
+		Y: cy,
+	}
+	coordinate := newPolarCoordinate(center, radius, count, getPolarStartAngle(polar.StartAngle))
+	var radiusData []string
+	if !isFalse(polar.RadiusLabelShow) {
+		radiusData = r.Values()
+	}
+	_, err := NewPolarAxisPainter(seriesPainter, coordinate, PolarAxisOption{
+		Theme:       theme,
+		Font:        opt.Font,
+		Shape:       PolarShapeCircle,
+		Data:        polar.Data,
+		RadiusData:  radiusData,
+		SplitNumber: splitNumber,
+	}).Render()
+	if err != nil {
+		return BoxZero, err
+	}
+
+	barSeriesList := seriesList.Filter(ChartTypePolarBar)
+	lineSeriesList := seriesList.Filter(ChartTypePolarLine)
+	seriesNames := seriesList.Names()
+	rendererList := []Renderer{}
+
+	// 每个分类两侧预留的角度
+	bandAngle := coordinate.BandAngle()
+	margin := bandAngle * 0.1
+	barAngle := float64(0)
+	if len(barSeriesList) != 0 {
+		barAngle = (bandAngle - 2*margin) / float64(len(barSeriesList))
+	}
+	for index, series := range barSeriesList {
+		seriesColor := theme.GetSeriesColor(series.index)
+		var labelPainter *SeriesLabelPainter
+		if series.Label.Show {
+			labelPainter = NewSeriesLabelPainter(SeriesLabelPainterParams{
+				P:           seriesPainter,
+				SeriesNames: seriesNames,
+				Label:       series.Label,
+				Theme:       opt.Theme,
+				Font:        opt.Font,
+			})
+			rendererList = append(rendererList, labelPainter)
+		}
+		seriesPainter.startSeriesGroup(series)
+		for j, item := range series.Data {
+			if j >= count || item.Value == nullValue {
+				continue
+			}
+			h := float64(r.getHeight(item.Value))
+			if h <= 0 {
+				continue
+			}
+			fillColor := seriesColor
+			if !item.Style.FillColor.IsZero() {
+				fillColor = item.Style.FillColor
+			}
+			start := coordinate.Angle(j) - bandAngle/2 + margin + float64(index)*barAngle
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeWidth: 1,
+				StrokeColor: fillColor,
+				FillColor:   fillColor,
+			})
+			seriesPainter.MoveTo(cx, cy)
+			seriesPainter.ArcTo(cx, cy, h, h, start, barAngle).
+				LineTo(cx, cy).
+				Close().
+				FillStroke()
+			if labelPainter == nil {
+				continue
+			}
+			p := getPolygonPoint(center, h, start+barAngle/2)
+			labelPainter.Add(LabelValue{
+				Index:     index,
+				Value:     item.Value,
+				X:         p.X,
+				Y:         p.Y,
+				FontColor: series.Label.Color,
+				FontSize:  series.Label.FontSize,
+				Offset:    series.Label.Offset,
+			})
+		}
+		seriesPainter.endGroup()
+	}
+
+	strokeWidth := opt.StrokeWidth
+	if strokeWidth == 0 {
+		strokeWidth = defaultStrokeWidth
+	}
+	for index, series := range lineSeriesList {
+		seriesColor := theme.GetSeriesColor(series.index)
+		drawingStyle := Style{
+			StrokeColor: seriesColor,
+			StrokeWidth: strokeWidth,
+		}
+		if len(series.Style.StrokeDashArray) > 0 {
+			drawingStyle.StrokeDashArray = series.Style.StrokeDashArray
+		}
+		var labelPainter *SeriesLabelPainter
+		if series.Label.Show {
+			labelPainter = NewSeriesLabelPainter(SeriesLabelPainterParams{
+				P:           seriesPainter,
+				SeriesNames: seriesNames,
+				Label:       series.Label,
+				Theme:       opt.Theme,
+				Font:        opt.Font,
+			})
+			rendererList = append(rendererList, labelPainter)
+		}
+		points := make([]Point, 0, len(series.Data)+1)
+		dots := make([]Point, 0, len(series.Data))
+		for j, item := range series.Data {
+			if j >= count {
+				continue
+			}
+			// 空值则断开
+			if item.Value == nullValue {
+				points = append(points, Point{
+					Y: int(math.MaxInt32),
+				})
+				continue
+			}
+			p := coordinate.Point(j, float64(r.getHeight(item.Value)))
+			points = append(points, p)
+			dots = append(dots, p)
+			if labelPainter == nil {
+				continue
+			}
+			labelPainter.Add(LabelValue{
+				Index:    len(barSeriesList) + index,
+				Value:    item.Value,
+				X:        p.X,
+				Y:        p.Y,
+				FontSize: series.Label.FontSize,
+			})
+		}
+		if len(dots) == 0 {
+			continue
+		}
+		seriesPainter.startSeriesGroup(series)
+		if polar.LineClosed && len(points) != 0 && len(dots) == len(points) {
+			points = append(points, points[0])
+			// 闭合的线才填充区域
+			if opt.FillArea {
+				var opacity uint8 = 200
+				if opt.Opacity != 0 {
+					opacity = opt.Opacity
+				}
+				seriesPainter.SetDrawingStyle(Style{
+					FillColor: seriesColor.WithAlpha(opacity),
+				})
+				seriesPainter.FillArea(points)
+			}
+		}
+		seriesPainter.SetDrawingStyle(drawingStyle)
+		seriesPainter.LineStroke(points)
+
+		// 画点
+		if theme.IsDark() {
+			drawingStyle.FillColor = drawingStyle.StrokeColor
+		} else {
+			drawingStyle.FillColor = drawing.ColorWhite
+		}
+		drawingStyle.StrokeWidth = 1
+		seriesPainter.SetDrawingStyle(drawingStyle)
+		if !isFalse(opt.SymbolShow) {
+			seriesPainter.Dots(dots)
+		}
+		seriesPainter.endGroup()
+	}
+	err = doRender(rendererList...)
+	if err != nil {
+		return BoxZero, err
+	}
+
+	return pc.p.box, nil
+}
+
+func (pc *polarChart) Render() (Box, error) {
+	p := pc.p
+	opt := pc.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:      opt.Theme,
+		Padding:    opt.Padding,
+		SeriesList: opt.SeriesList,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption:        opt.Title,
+		LegendOption:       opt.Legend,
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypePolarBar)
+	seriesList = append(seriesList, opt.SeriesList.Filter(ChartTypePolarLine)...)
+	return pc.render(renderResult, seriesList)
+}
@@ -24,6 +24,7 @@ package charts
 
 import (
 	"errors"
+	"math"
 
 	"github.com/dustin/go-humanize"
 	"github.com/golang/freetype/truetype"
@@ -130,69 +131,27 @@ func (r *radarChart) render(result *defaultRenderResult, seriesList SeriesList)
 	divideRadius := float64(int(radius / float64(divideCount)))
 	radius = divideRadius * float64(divideCount)
 
-	seriesPainter.OverrideDrawingStyle(Style{
-		StrokeColor: theme.GetAxisSplitLineColor(),
-		StrokeWidth: 1,
-	})
 	center := Point{
 		X: cx,
 		Y: cy,
 	}
-	for i := 0; i < divideCount; i++ {
-		seriesPainter.Polygon(center, divideRadius*float64(i+1), sides)
-	}
-	points := getPolygonPoints(center, radius, sides)
-	for _, p := range points {
-		seriesPainter.MoveTo(center.X, center.Y)
-		seriesPainter.LineTo(p.X, p.Y)
-		seriesPainter.Stroke()
+	names := make([]string, len(indicators))
+	for index, indicator := range indicators {
+		names[index] = indicator.Name
 	}
-	seriesPainter.OverrideTextStyle(Style{
-		FontColor: theme.GetTextColor(),
-		FontSize:  labelFontSize,
-		Font:      opt.Font,
-	})
-	offset := 5
-	// 文本生成
-	for index, p := range points {
-		name := indicators[index].Name
-		b := seriesPainter.MeasureText(name)
-		isXCenter := p.X == center.X
-		isYCenter := p.Y == center.Y
-		isRight := p.X > center.X
-		isLeft := p.X < center.X
-		isTop := p.Y < center.Y
-		isBottom := p.Y > center.Y
-		x := p.X
-		y := p.Y
-		if isXCenter {
-			x -= b.Width() >> 1
-			if isTop {
-				y -= b.Height()
-			} else {
-				y += b.Height()
-			}
-		}
-		if isYCenter {
-			y += b.Height() >> 1
-		}
-		if isTop {
-			y += offset
-		}
-		if isBottom {
-			y += offset
-		}
-		if isRight {
-			x += offset
-		}
-		if isLeft {
-			x -= (b.Width() + offset)
-		}
-		seriesPainter.Text(name, x, y)
+	coordinate := newPolarCoordinate(center, radius, sides, -math.Pi/2)
+	_, err := NewPolarAxisPainter(seriesPainter, coordinate, PolarAxisOption{
+		Theme:       theme,
+		Font:        opt.Font,
+		Shape:       PolarShapePolygon,
+		Data:        names,
+		SplitNumber: divideCount,
+	}).Render()
+	if err != nil {
+		return BoxZero, err
 	}
 
 	// 雷达图
-	angles := getPolygonPointAngles(sides)
 	maxCount := len(indicators)
 	for _, series := range seriesList {
 		linePoints := make([]Point, 0, maxCount)
@@ -207,7 +166,7 @@ func (r *radarChart) render(result *defaultRenderResult, seriesList SeriesList)
 				percent = (item.Value - indicator.Min) / offset
 			}
 			r := percent * radius
-			p := getPolygonPoint(center, r, angles[j])
+			p := coordinate.Point(j, r)
 			linePoints = append(linePoints, p)
 		}
 		color := theme.GetSeriesColor(series.index)
@@ -216,6 +175,7 @@ func (r *radarChart) render(result *defaultRenderResult, seriesList SeriesList)
 			dotFillColor = color
 		}
 		linePoints = append(linePoints, linePoints[0])
+		seriesPainter.startSeriesGroup(series)
 		seriesPainter.OverrideDrawingStyle(Style{
 			StrokeColor: color,
 			StrokeWidth: defaultStrokeWidth,
@@ -234,6 +194,10 @@ func (r *radarChart) render(result *defaultRenderResult, seriesList SeriesList)
 		for index, point := range linePoints {
 			seriesPainter.Circle(dotWith, point.X, point.Y)
 			seriesPainter.FillStroke()
+			if index < len(series.Data) && index < maxCount {
+				seriesPainter.hitCircle(point.X, point.Y, 6, seriesPainter.formatTooltip(series, indicators[index].Name, series.Data[index].Value))
+				seriesPainter.addSeriesPoint(series, index, series.Data[index].Value, point.X, point.Y, 6)
+			}
 			if series.Label.Show && index < len(series.Data) {
 				value := humanize.FtoaWithDigits(series.Data[index].Value, 2)
 				b := seriesPainter.MeasureText(value)
@@ -241,6 +205,7 @@ func (r *radarChart) render(result *defaultRenderResult, seriesList SeriesList)
 			}
 
 		}
+		seriesPainter.endGroup()
 	}
 
 	return r.p.box, nil
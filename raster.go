@@ -0,0 +1,456 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"sync"
+
+	"github.com/golang/freetype/raster"
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// maxRGBAPoolCount is the max count of image sizes which are pooled,
+// the images of other sizes are not reused
+const maxRGBAPoolCount = 16
+
+// rgbaPools caches the released rgba images, the key is the size of image
+var (
+	rgbaPoolsMutex sync.Mutex
+	rgbaPools      = make(map[image.Point]*sync.Pool)
+)
+
+type pngBufferPool struct {
+	pool sync.Pool
+}
+
+func (p *pngBufferPool) Get() *png.EncoderBuffer {
+	b, _ := p.pool.Get().(*png.EncoderBuffer)
+	return b
+}
+
+func (p *pngBufferPool) Put(b *png.EncoderBuffer) {
+	p.pool.Put(b)
+}
+
+// pngEncoder reuses the buffers of png encoding
+var pngEncoder = &png.Encoder{
+	BufferPool: &pngBufferPool{},
+}
+
+// getRGBAPool returns the pool of image size, the pool is created if create is true
+// and the count of pools is less than max count, otherwise nil is returned
+func getRGBAPool(width, height int, create bool) *sync.Pool {
+	key := image.Point{
+		X: width,
+		Y: height,
+	}
+	rgbaPoolsMutex.Lock()
+	defer rgbaPoolsMutex.Unlock()
+	pool, ok := rgbaPools[key]
+	if ok || !create || len(rgbaPools) >= maxRGBAPoolCount {
+		return pool
+	}
+	pool = &sync.Pool{}
+	rgbaPools[key] = pool
+	return pool
+}
+
+// getRGBA returns a transparent rgba image, the released image is reused if exists
+func getRGBA(width, height int) *image.RGBA {
+	var img *image.RGBA
+	if pool := getRGBAPool(width, height, false); pool != nil {
+		img, _ = pool.Get().(*image.RGBA)
+	}
+	if img == nil {
+		return image.NewRGBA(image.Rect(0, 0, width, height))
+	}
+	// 重置为透明
+	for i := range img.Pix {
+		img.Pix[i] = 0
+	}
+	return img
+}
+
+// putRGBA releases the rgba image for reusing
+func putRGBA(img *image.RGBA) {
+	size := img.Bounds().Size()
+	if pool := getRGBAPool(size.X, size.Y, true); pool != nil {
+		pool.Put(img)
+	}
+}
+
+// gradientPainter paints the spans with gradient color,
+// the color painter is used if the gradient is not active
+type gradientPainter struct {
+	*raster.RGBAPainter
+	gradient *Gradient
+	box      pathBounds
+	// 下一次SetColor时启用渐变，仅对填充生效
+	pending bool
+	active  bool
+}
+
+func (gp *gradientPainter) SetColor(c color.Color) {
+	gp.active = gp.pending && gp.gradient != nil
+	gp.pending = false
+	gp.RGBAPainter.SetColor(c)
+}
+
+func (gp *gradientPainter) Paint(ss []raster.Span, done bool) {
+	if !gp.active {
+		gp.RGBAPainter.Paint(ss, done)
+		return
+	}
+	img := gp.Image
+	b := img.Bounds()
+	const m = 1<<16 - 1
+	for _, s := range ss {
+		if s.Y < b.Min.Y {
+			continue
+		}
+		if s.Y >= b.Max.Y {
+			return
+		}
+		if s.X0 < b.Min.X {
+			s.X0 = b.Min.X
+		}
+		if s.X1 > b.Max.X {
+			s.X1 = b.Max.X
+		}
+		if s.X0 >= s.X1 {
+			continue
+		}
+		ma := s.Alpha
+		i := (s.Y-img.Rect.Min.Y)*img.Stride + (s.X0-img.Rect.Min.X)*4
+		for x := s.X0; x < s.X1; x++ {
+			// 以像素中心计算渐变颜色
+			c := gp.gradient.getColorAt(float64(x)+0.5, float64(s.Y)+0.5, gp.box)
+			cr, cg, cb, ca := c.RGBA()
+			dr := uint32(img.Pix[i+0])
+			dg := uint32(img.Pix[i+1])
+			db := uint32(img.Pix[i+2])
+			da := uint32(img.Pix[i+3])
+			a := (m - (ca * ma / m)) * 0x101
+			img.Pix[i+0] = uint8((dr*a + cr*ma) / m >> 8)
+			img.Pix[i+1] = uint8((dg*a + cg*ma) / m >> 8)
+			img.Pix[i+2] = uint8((db*a + cb*ma) / m >> 8)
+			img.Pix[i+3] = uint8((da*a + ca*ma) / m >> 8)
+			i += 4
+		}
+	}
+}
+
+// rasterRenderer renders chart commands to a bitmap, it is the same as
+// the png renderer of go-chart, but the bitmap can be released for reusing
+type rasterRenderer struct {
+	i       *image.RGBA
+	gc      *drawing.RasterGraphicContext
+	painter *gradientPainter
+
+	rotateRadians *float64
+
+	s chart.Style
+}
+
+// newRasterRenderer returns a new raster renderer
+func newRasterRenderer(width, height int) (chart.Renderer, error) {
+	i := getRGBA(width, height)
+	painter := &gradientPainter{
+		RGBAPainter: raster.NewRGBAPainter(i),
+	}
+	return &rasterRenderer{
+		i:       i,
+		gc:      drawing.NewRasterGraphicContextWithPainter(i, painter),
+		painter: painter,
+	}, nil
+}
+
+// released returns true if the bitmap is released, the drawing is ignored after released
+func (rr *rasterRenderer) released() bool {
+	return rr.gc == nil
+}
+
+func (rr *rasterRenderer) ResetStyle() {
+	if rr.released() {
+		return
+	}
+	rr.s = chart.Style{Font: rr.s.Font}
+	rr.painter.gradient = nil
+	rr.ClearTextRotation()
+}
+
+func (rr *rasterRenderer) setFillGradient(g *Gradient) {
+	if rr.released() {
+		return
+	}
+	rr.painter.gradient = g
+}
+
+// paint paints the current path, the gradient is only used for filling
+func (rr *rasterRenderer) paint(fill bool, fn func()) {
+	rr.painter.pending = fill
+	fn()
+	rr.painter.pending = false
+	rr.painter.box.reset()
+}
+
+func (rr *rasterRenderer) GetDPI() float64 {
+	if rr.released() {
+		return chart.DefaultDPI
+	}
+	return rr.gc.GetDPI()
+}
+
+func (rr *rasterRenderer) SetDPI(dpi float64) {
+	if rr.released() {
+		return
+	}
+	rr.gc.SetDPI(dpi)
+}
+
+func (rr *rasterRenderer) SetClassName(_ string) {}
+
+func (rr *rasterRenderer) SetStrokeColor(c drawing.Color) {
+	rr.s.StrokeColor = c
+}
+
+func (rr *rasterRenderer) SetStrokeWidth(width float64) {
+	rr.s.StrokeWidth = width
+}
+
+func (rr *rasterRenderer) SetStrokeDashArray(dashArray []float64) {
+	rr.s.StrokeDashArray = dashArray
+}
+
+func (rr *rasterRenderer) SetFillColor(c drawing.Color) {
+	rr.s.FillColor = c
+}
+
+func (rr *rasterRenderer) MoveTo(x, y int) {
+	if rr.released() {
+		return
+	}
+	rr.painter.box.add(float64(x), float64(y))
+	rr.gc.MoveTo(float64(x), float64(y))
+}
+
+func (rr *rasterRenderer) LineTo(x, y int) {
+	if rr.released() {
+		return
+	}
+	rr.painter.box.add(float64(x), float64(y))
+	rr.gc.LineTo(float64(x), float64(y))
+}
+
+func (rr *rasterRenderer) QuadCurveTo(cx, cy, x, y int) {
+	if rr.released() {
+		return
+	}
+	rr.painter.box.addQuad(float64(cx), float64(cy), float64(x), float64(y))
+	rr.gc.QuadCurveTo(float64(cx), float64(cy), float64(x), float64(y))
+}
+
+func (rr *rasterRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	if rr.released() {
+		return
+	}
+	rr.painter.box.addArc(float64(cx), float64(cy), rx, ry, startAngle, delta)
+	rr.gc.ArcTo(float64(cx), float64(cy), rx, ry, startAngle, delta)
+}
+
+func (rr *rasterRenderer) Close() {
+	if rr.released() {
+		return
+	}
+	rr.gc.Close()
+}
+
+func (rr *rasterRenderer) Stroke() {
+	if rr.released() {
+		return
+	}
+	rr.gc.SetStrokeColor(rr.s.StrokeColor)
+	rr.gc.SetLineWidth(rr.s.StrokeWidth)
+	rr.gc.SetLineDash(rr.s.StrokeDashArray, 0)
+	rr.paint(false, func() {
+		rr.gc.Stroke()
+	})
+}
+
+func (rr *rasterRenderer) Fill() {
+	if rr.released() {
+		return
+	}
+	rr.gc.SetFillColor(rr.s.FillColor)
+	rr.paint(true, func() {
+		rr.gc.Fill()
+	})
+}
+
+func (rr *rasterRenderer) FillStroke() {
+	if rr.released() {
+		return
+	}
+	rr.gc.SetFillColor(rr.s.FillColor)
+	rr.gc.SetStrokeColor(rr.s.StrokeColor)
+	rr.gc.SetLineWidth(rr.s.StrokeWidth)
+	rr.gc.SetLineDash(rr.s.StrokeDashArray, 0)
+	rr.paint(true, func() {
+		rr.gc.FillStroke()
+	})
+}
+
+// Circle draws a circle at a given point but does not apply the fill or stroke
+func (rr *rasterRenderer) Circle(radius float64, x, y int) {
+	if rr.released() {
+		return
+	}
+	xf := float64(x)
+	yf := float64(y)
+
+	rr.painter.box.add(xf-radius, yf-radius)
+	rr.painter.box.add(xf+radius, yf+radius)
+	rr.gc.MoveTo(xf-radius, yf)
+	rr.gc.QuadCurveTo(xf-radius, yf-radius, xf, yf-radius)
+	rr.gc.QuadCurveTo(xf+radius, yf-radius, xf+radius, yf)
+	rr.gc.QuadCurveTo(xf+radius, yf+radius, xf, yf+radius)
+	rr.gc.QuadCurveTo(xf-radius, yf+radius, xf-radius, yf)
+}
+
+func (rr *rasterRenderer) SetFont(f *truetype.Font) {
+	rr.s.Font = f
+}
+
+func (rr *rasterRenderer) SetFontSize(size float64) {
+	rr.s.FontSize = size
+}
+
+func (rr *rasterRenderer) SetFontColor(c drawing.Color) {
+	rr.s.FontColor = c
+}
+
+func (rr *rasterRenderer) Text(body string, x, y int) {
+	if rr.released() {
+		return
+	}
+	xf, yf := rr.getCoords(x, y)
+	rr.gc.SetFont(rr.s.Font)
+	rr.gc.SetFontSize(rr.s.FontSize)
+	rr.gc.SetFillColor(rr.s.FontColor)
+	rr.gc.CreateStringPath(body, float64(xf), float64(yf))
+	rr.gc.Fill()
+}
+
+// MeasureText returns the height and width in pixels of a string
+func (rr *rasterRenderer) MeasureText(body string) chart.Box {
+	if rr.released() {
+		return chart.Box{}
+	}
+	rr.gc.SetFont(rr.s.Font)
+	rr.gc.SetFontSize(rr.s.FontSize)
+	rr.gc.SetFillColor(rr.s.FontColor)
+	l, t, r, b, err := rr.gc.GetStringBounds(body)
+	if err != nil {
+		return chart.Box{}
+	}
+	if l < 0 {
+		r = r - l
+		l = 0
+	}
+	if t < 0 {
+		b = b - t
+		t = 0
+	}
+	if l > 0 {
+		r = r + l
+		l = 0
+	}
+	if t > 0 {
+		b = b + t
+		t = 0
+	}
+
+	textBox := chart.Box{
+		Top:    int(math.Ceil(t)),
+		Left:   int(math.Ceil(l)),
+		Right:  int(math.Ceil(r)),
+		Bottom: int(math.Ceil(b)),
+	}
+	if rr.rotateRadians == nil {
+		return textBox
+	}
+	return textBox.Corners().Rotate(chart.RadiansToDegrees(*rr.rotateRadians)).Box()
+}
+
+func (rr *rasterRenderer) SetTextRotation(radians float64) {
+	rr.rotateRadians = &radians
+}
+
+func (rr *rasterRenderer) getCoords(x, y int) (xf, yf int) {
+	if rr.rotateRadians == nil {
+		xf = x
+		yf = y
+		return
+	}
+	rr.gc.Translate(float64(x), float64(y))
+	rr.gc.Rotate(*rr.rotateRadians)
+	return
+}
+
+func (rr *rasterRenderer) ClearTextRotation() {
+	if rr.released() {
+		return
+	}
+	rr.gc.SetMatrixTransform(drawing.NewIdentityMatrix())
+	rr.rotateRadians = nil
+}
+
+// Save encodes the bitmap as png, or passes the bitmap to collector
+func (rr *rasterRenderer) Save(w io.Writer) error {
+	if rr.released() {
+		return ErrPainterReleased
+	}
+	if typed, ok := w.(chart.RGBACollector); ok {
+		typed.SetRGBA(rr.i)
+		return nil
+	}
+	return pngEncoder.Encode(w, rr.i)
+}
+
+// release puts the bitmap back to the pool, the drawing of renderer is ignored after released
+func (rr *rasterRenderer) release() {
+	if rr.released() {
+		return
+	}
+	putRGBA(rr.i)
+	rr.i = nil
+	rr.gc = nil
+	rr.painter = nil
+}
@@ -0,0 +1,258 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"bytes"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	RegionTypeBar       = "bar"
+	RegionTypePoint     = "point"
+	RegionTypeSector    = "sector"
+	RegionTypeLegend    = "legend"
+	RegionTypeAxisLabel = "axisLabel"
+)
+
+const (
+	RegionShapeRect   = "rect"
+	RegionShapeCircle = "circle"
+	RegionShapePoly   = "poly"
+)
+
+// Region is the hit region of chart's element,
+// the coordinates are relative to the image without pixel ratio
+type Region struct {
+	// The type of region: bar, point, sector, legend or axisLabel
+	Type string
+	// The shape of region: rect, circle or poly
+	Shape string
+	// The index of series, it is -1 if the region is not belong to series
+	SeriesIndex int
+	// The index of data, it is -1 if the region is not belong to data
+	DataIndex int
+	// The name of region, series name for data and text for legend or axis label
+	Name string
+	// The value of data
+	Value float64
+	// The bounding box of region
+	Box Box
+	// The polygon points of region, only for poly shape
+	Points []Point
+}
+
+// Regions is the list of hit region
+type Regions []Region
+
+type regionCollector struct {
+	regions Regions
+}
+
+// Coords returns the coords of html area
+func (r Region) Coords() string {
+	var values []int
+	switch r.Shape {
+	case RegionShapeCircle:
+		values = []int{
+			(r.Box.Left + r.Box.Right) >> 1,
+			(r.Box.Top + r.Box.Bottom) >> 1,
+			r.Box.Width() >> 1,
+		}
+	case RegionShapePoly:
+		values = make([]int, 0, 2*len(r.Points))
+		for _, p := range r.Points {
+			values = append(values, p.X, p.Y)
+		}
+	default:
+		values = []int{
+			r.Box.Left,
+			r.Box.Top,
+			r.Box.Right,
+			r.Box.Bottom,
+		}
+	}
+	arr := make([]string, len(values))
+	for index, v := range values {
+		arr[index] = strconv.Itoa(v)
+	}
+	return strings.Join(arr, ",")
+}
+
+// Filter returns the regions of the type
+func (rs Regions) Filter(regionType string) Regions {
+	arr := make(Regions, 0)
+	for _, item := range rs {
+		if item.Type == regionType {
+			arr = append(arr, item)
+		}
+	}
+	return arr
+}
+
+// HTMLMap returns the html map of regions, the href function returns the link of region,
+// the area without link will be ignored if href function is nil or returns empty string.
+// The areas are written in the reverse order of drawing,
+// so the element drawn on top is matched first.
+func (rs Regions) HTMLMap(name string, href func(Region) string) string {
+	buf := bytes.Buffer{}
+	buf.WriteString(`<map name="` + html.EscapeString(name) + `">`)
+	for i := len(rs) - 1; i >= 0; i-- {
+		r := rs[i]
+		link := ""
+		if href != nil {
+			link = href(r)
+		}
+		if link == "" {
+			continue
+		}
+		shape := r.Shape
+		if shape == "" {
+			shape = RegionShapeRect
+		}
+		buf.WriteString(`<area shape="` + shape + `" coords="` + r.Coords() + `" href="` + html.EscapeString(link) + `" alt="` + html.EscapeString(r.Name) + `">`)
+	}
+	buf.WriteString("</map>")
+	return buf.String()
+}
+
+// Regions returns the hit regions of the elements drawn by painter
+func (p *Painter) Regions() Regions {
+	if p.regions == nil {
+		return nil
+	}
+	arr := make(Regions, len(p.regions.regions))
+	copy(arr, p.regions.regions)
+	return arr
+}
+
+// addRegion adds the hit region, the coordinates are relative to painter
+func (p *Painter) addRegion(r Region) *Painter {
+	if p.regions == nil {
+		return p
+	}
+	if r.Shape == "" {
+		r.Shape = RegionShapeRect
+	}
+	r.Box = Box{
+		Left:   r.Box.Left + p.box.Left,
+		Top:    r.Box.Top + p.box.Top,
+		Right:  r.Box.Right + p.box.Left,
+		Bottom: r.Box.Bottom + p.box.Top,
+	}
+	if len(r.Points) != 0 {
+		points := make([]Point, len(r.Points))
+		for index, point := range r.Points {
+			points[index] = Point{
+				X: point.X + p.box.Left,
+				Y: point.Y + p.box.Top,
+			}
+		}
+		r.Points = points
+	}
+	p.regions.regions = append(p.regions.regions, r)
+	return p
+}
+
+// addSeriesRect adds the rect region of series data
+func (p *Painter) addSeriesRect(regionType string, series Series, dataIndex int, value float64, box Box) *Painter {
+	return p.addRegion(Region{
+		Type:        regionType,
+		SeriesIndex: series.index,
+		DataIndex:   dataIndex,
+		Name:        getAccessibleName(series),
+		Value:       value,
+		Box:         box,
+	})
+}
+
+// addSeriesPoint adds the circle region of series data
+func (p *Painter) addSeriesPoint(series Series, dataIndex int, value float64, x, y, radius int) *Painter {
+	return p.addRegion(Region{
+		Type:        RegionTypePoint,
+		Shape:       RegionShapeCircle,
+		SeriesIndex: series.index,
+		DataIndex:   dataIndex,
+		Name:        getAccessibleName(series),
+		Value:       value,
+		Box: Box{
+			Left:   x - radius,
+			Top:    y - radius,
+			Right:  x + radius,
+			Bottom: y + radius,
+		},
+	})
+}
+
+// newSectorPoints returns the polygon points of sector
+func newSectorPoints(cx, cy int, radius, start, delta float64) []Point {
+	// 每一段不超过5度
+	count := int(math.Ceil(math.Abs(delta) / (math.Pi / 36)))
+	if count < 1 {
+		count = 1
+	}
+	points := make([]Point, 0, count+2)
+	points = append(points, Point{
+		X: cx,
+		Y: cy,
+	})
+	for i := 0; i <= count; i++ {
+		angle := start + delta*float64(i)/float64(count)
+		points = append(points, Point{
+			X: cx + int(math.Round(radius*math.Cos(angle))),
+			Y: cy + int(math.Round(radius*math.Sin(angle))),
+		})
+	}
+	return points
+}
+
+// getPointsBox returns the bounding box of points
+func getPointsBox(points []Point) Box {
+	if len(points) == 0 {
+		return BoxZero
+	}
+	box := Box{
+		Left:   points[0].X,
+		Top:    points[0].Y,
+		Right:  points[0].X,
+		Bottom: points[0].Y,
+	}
+	for _, p := range points[1:] {
+		if p.X < box.Left {
+			box.Left = p.X
+		}
+		if p.X > box.Right {
+			box.Right = p.X
+		}
+		if p.Y < box.Top {
+			box.Top = p.Y
+		}
+		if p.Y > box.Bottom {
+			box.Bottom = p.Y
+		}
+	}
+	return box
+}
@@ -0,0 +1,160 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegionCoords(t *testing.T) {
+	assert := assert.New(t)
+
+	box := Box{
+		Left:   10,
+		Top:    20,
+		Right:  30,
+		Bottom: 40,
+	}
+	assert.Equal("10,20,30,40", Region{
+		Box: box,
+	}.Coords())
+	assert.Equal("20,30,10", Region{
+		Shape: RegionShapeCircle,
+		Box:   box,
+	}.Coords())
+	assert.Equal("1,2,3,4,5,6", Region{
+		Shape: RegionShapePoly,
+		Points: []Point{
+			{X: 1, Y: 2},
+			{X: 3, Y: 4},
+			{X: 5, Y: 6},
+		},
+	}.Coords())
+}
+
+func TestRegionsHTMLMap(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputPNG,
+		Width:  400,
+		Height: 300,
+	})
+	assert.Nil(err)
+	child := p.Child(PainterPaddingOption(Box{
+		Left: 10,
+		Top:  20,
+	}))
+	child.addSeriesRect(RegionTypeBar, Series{
+		Name: "Email",
+	}, 0, 120, Box{
+		Left:   0,
+		Top:    0,
+		Right:  20,
+		Bottom: 50,
+	})
+	child.addSeriesPoint(Series{
+		index: 1,
+	}, 2, 10, 50, 60, 6)
+
+	regions := p.Regions()
+	assert.Equal(Regions{
+		{
+			Type:        RegionTypeBar,
+			Shape:       RegionShapeRect,
+			SeriesIndex: 0,
+			DataIndex:   0,
+			Name:        "Email",
+			Value:       120,
+			Box: Box{
+				Left:   10,
+				Top:    20,
+				Right:  30,
+				Bottom: 70,
+			},
+		},
+		{
+			Type:        RegionTypePoint,
+			Shape:       RegionShapeCircle,
+			SeriesIndex: 1,
+			DataIndex:   2,
+			Name:        "Series 2",
+			Value:       10,
+			Box: Box{
+				Left:   54,
+				Top:    74,
+				Right:  66,
+				Bottom: 86,
+			},
+		},
+	}, regions)
+	assert.Equal(1, len(regions.Filter(RegionTypePoint)))
+
+	assert.Equal(`<map name="chart"><area shape="circle" coords="60,80,6" href="/detail?series=1&amp;index=2" alt="Series 2"><area shape="rect" coords="10,20,30,70" href="/detail?series=0&amp;index=0" alt="Email"></map>`, regions.HTMLMap("chart", func(r Region) string {
+		return "/detail?series=" + strconv.Itoa(r.SeriesIndex) + "&index=" + strconv.Itoa(r.DataIndex)
+	}))
+	assert.Equal(`<map name="chart"></map>`, regions.HTMLMap("chart", nil))
+}
+
+func TestChartRegions(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := BarRender([][]float64{
+		{120, 132},
+		{220, 182},
+	},
+		XAxisDataOptionFunc([]string{"Mon", "Tue"}),
+		LegendLabelsOptionFunc([]string{"Email", "Union Ads"}),
+	)
+	assert.Nil(err)
+	regions := p.Regions()
+	bars := regions.Filter(RegionTypeBar)
+	assert.Equal(4, len(bars))
+	assert.Equal(1, bars[3].SeriesIndex)
+	assert.Equal(1, bars[3].DataIndex)
+	assert.Equal("Union Ads", bars[3].Name)
+	assert.Equal(182.0, bars[3].Value)
+	legends := regions.Filter(RegionTypeLegend)
+	assert.Equal(2, len(legends))
+	assert.Equal("Email", legends[0].Name)
+	assert.Equal(-1, legends[0].DataIndex)
+	// y轴先于x轴绘制
+	labels := regions.Filter(RegionTypeAxisLabel)
+	assert.Equal("Mon", labels[len(labels)-2].Name)
+	assert.Equal("Tue", labels[len(labels)-1].Name)
+
+	p, err = PieRender([]float64{
+		1048,
+		735,
+	})
+	assert.Nil(err)
+	sectors := p.Regions().Filter(RegionTypeSector)
+	assert.Equal(2, len(sectors))
+	for _, item := range sectors {
+		assert.Equal(RegionShapePoly, item.Shape)
+		assert.True(len(item.Points) > 3)
+	}
+}
@@ -34,6 +34,8 @@ type SeriesData struct {
 	Value float64
 	// The style of series data
 	Style Style
+	// The gradient fill of series data, it is used instead of the fill color
+	FillGradient *Gradient
 }
 
 // NewSeriesListDataFromValues returns a series list
@@ -120,6 +122,10 @@ type Series struct {
 	AxisIndex int
 	// The style for series
 	Style chart.Style
+	// The gradient fill for series, it is used for the bar, area of line and pie
+	FillGradient *Gradient
+	// Fill the area of line series, the FillArea of chart option fills all line series
+	FillArea bool
 	// The label for series
 	Label SeriesLabel
 	// The name of series
@@ -164,6 +170,30 @@ func (sl SeriesList) Filter(chartType string) SeriesList {
 	return arr
 }
 
+// axisIndexes returns the axis index list of series list
+func (sl SeriesList) axisIndexes() []int {
+	axisIndexList := make([]int, 0)
+	for _, series := range sl {
+		if containsInt(axisIndexList, series.AxisIndex) {
+			continue
+		}
+		axisIndexList = append(axisIndexList, series.AxisIndex)
+	}
+	return axisIndexList
+}
+
+// hasValue checks whether the series list has any value which is not null
+func (sl SeriesList) hasValue() bool {
+	for _, series := range sl {
+		for _, item := range series.Data {
+			if item.Value != nullValue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // GetMaxMin get max and min value of series list
 func (sl SeriesList) GetMaxMin(axisIndex int) (float64, float64) {
 	min := math.MaxFloat64
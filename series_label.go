@@ -136,6 +136,8 @@ func (o *SeriesLabelPainter) Add(value LabelValue) {
 }
 
 func (o *SeriesLabelPainter) Render() (Box, error) {
+	o.p.startComponent("label")
+	defer o.p.endComponent()
 	for _, item := range o.values {
 		o.p.OverrideTextStyle(item.Style)
 		if item.Radians != 0 {
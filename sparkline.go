@@ -0,0 +1,152 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// The padding of sparkline with highlight points, it keeps the points inside the canvas.
+// The sparkline without highlight points has no padding
+const defaultSparklinePadding = 3
+
+type SparklineOption struct {
+	// The flag for sparkline mode, title, legend and axes are hidden
+	// and the series fills the whole canvas
+	Enabled bool
+	// The flag for highlight the last point
+	LastShow bool
+	// The flag for highlight the min point
+	MinShow bool
+	// The flag for highlight the max point
+	MaxShow bool
+	// The radius of highlight point, default is 2.5
+	SymbolSize float64
+	// The color of last point, default is the series color
+	LastColor Color
+	// The color of min point
+	MinColor Color
+	// The color of max point
+	MaxColor Color
+}
+
+var (
+	defaultSparklineMinColor = drawing.Color{
+		R: 238,
+		G: 102,
+		B: 102,
+		A: 255,
+	}
+	defaultSparklineMaxColor = drawing.Color{
+		R: 145,
+		G: 204,
+		B: 117,
+		A: 255,
+	}
+)
+
+// hasHighlight returns true if any highlight point is shown
+func (opt *SparklineOption) hasHighlight() bool {
+	return opt.LastShow || opt.MinShow || opt.MaxShow
+}
+
+type sparklineHighlight struct {
+	index int
+	color Color
+}
+
+// getHighlights returns the highlight points of series data,
+// the latter one overrides the former if they are the same point
+func (opt *SparklineOption) getHighlights(data []SeriesData, seriesColor Color) []sparklineHighlight {
+	minIndex := -1
+	maxIndex := -1
+	lastIndex := -1
+	for index, item := range data {
+		if item.Value == nullValue {
+			continue
+		}
+		if minIndex == -1 || item.Value < data[minIndex].Value {
+			minIndex = index
+		}
+		if maxIndex == -1 || item.Value > data[maxIndex].Value {
+			maxIndex = index
+		}
+		lastIndex = index
+	}
+	if lastIndex == -1 {
+		return nil
+	}
+	highlights := make([]sparklineHighlight, 0, 3)
+	if opt.MinShow {
+		color := opt.MinColor
+		if color.IsZero() {
+			color = defaultSparklineMinColor
+		}
+		highlights = append(highlights, sparklineHighlight{
+			index: minIndex,
+			color: color,
+		})
+	}
+	if opt.MaxShow {
+		color := opt.MaxColor
+		if color.IsZero() {
+			color = defaultSparklineMaxColor
+		}
+		highlights = append(highlights, sparklineHighlight{
+			index: maxIndex,
+			color: color,
+		})
+	}
+	if opt.LastShow {
+		color := opt.LastColor
+		if color.IsZero() {
+			color = seriesColor
+		}
+		highlights = append(highlights, sparklineHighlight{
+			index: lastIndex,
+			color: color,
+		})
+	}
+	return highlights
+}
+
+// renderHighlights renders the highlight points of sparkline
+func (opt *SparklineOption) renderHighlights(p *Painter, points []Point, data []SeriesData, seriesColor Color) {
+	radius := opt.SymbolSize
+	if radius <= 0 {
+		radius = 2.5
+	}
+	for _, item := range opt.getHighlights(data, seriesColor) {
+		if item.index >= len(points) {
+			continue
+		}
+		point := points[item.index]
+		p.OverrideDrawingStyle(Style{
+			FillColor:   item.color,
+			StrokeColor: item.color,
+			StrokeWidth: 1,
+		})
+		p.Circle(radius, point.X, point.Y)
+		p.FillStroke()
+	}
+}
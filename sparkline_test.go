@@ -0,0 +1,73 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparklineGetHighlights(t *testing.T) {
+	assert := assert.New(t)
+
+	seriesColor := Color{
+		R: 84,
+		G: 112,
+		B: 198,
+		A: 255,
+	}
+	data := NewSeriesDataFromValues([]float64{
+		5,
+		2,
+		9,
+		GetNullValue(),
+	})
+
+	opt := SparklineOption{}
+	assert.Empty(opt.getHighlights(data, seriesColor))
+
+	opt = SparklineOption{
+		LastShow: true,
+		MinShow:  true,
+		MaxShow:  true,
+	}
+	assert.Equal([]sparklineHighlight{
+		{
+			index: 1,
+			color: defaultSparklineMinColor,
+		},
+		{
+			index: 2,
+			color: defaultSparklineMaxColor,
+		},
+		{
+			index: 2,
+			color: seriesColor,
+		},
+	}, opt.getHighlights(data, seriesColor))
+
+	assert.Nil(opt.getHighlights(NewSeriesDataFromValues([]float64{
+		GetNullValue(),
+	}), seriesColor))
+}
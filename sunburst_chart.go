@@ -0,0 +1,378 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type sunburstChart struct {
+	p   *Painter
+	opt *SunburstChartOption
+}
+
+const (
+	SunburstLabelRotateRadial     = "radial"
+	SunburstLabelRotateTangential = "tangential"
+)
+
+type SunburstData struct {
+	// The name of data item
+	Name string
+	// The value of data item, the sum of children will be used if it is less than the sum
+	Value float64
+	// The color of data item, the color of parent will be used if it is nil
+	Color Color
+	// The children of data item
+	Children []SunburstData
+}
+
+type SunburstLevel struct {
+	// The inner radius of level, e.g.: 10%
+	InnerRadius string
+	// The outer radius of level, e.g.: 30%
+	Radius string
+	// The flag for show label, set this to *false will hide label
+	LabelShow *bool
+	// The font size of label
+	FontSize float64
+	// The color of label
+	FontColor Color
+}
+
+type SunburstOption struct {
+	// The data of sunburst
+	Data []SunburstData
+	// The option of each level, the first one is for the root
+	Levels []SunburstLevel
+	// The inner radius of sunburst, e.g.: 10%, default is 0
+	InnerRadius string
+	// The outer radius of sunburst, e.g.: 40%, default is "40%"
+	Radius string
+	// The rotation of label, it can be "radial" or "tangential", default is "radial"
+	LabelRotate string
+}
+
+type SunburstChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The padding of sunburst chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The sunburst option
+	Sunburst SunburstOption
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewSunburstChart returns a sunburst chart renderer
+func NewSunburstChart(p *Painter, opt SunburstChartOption) *sunburstChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &sunburstChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// Sum returns the value of sunburst data,
+// which is the larger one of value and the sum of children
+func (sd SunburstData) Sum() float64 {
+	sum := float64(0)
+	for _, item := range sd.Children {
+		sum += item.Sum()
+	}
+	if sd.Value > sum {
+		return sd.Value
+	}
+	return sum
+}
+
+func getSunburstDepth(data []SunburstData) int {
+	depth := 0
+	for _, item := range data {
+		depth = chart.MaxInt(depth, getSunburstDepth(item.Children)+1)
+	}
+	return depth
+}
+
+// parseRadius parses the radius value, it supports percent and pixel value
+func parseRadius(diameter float64, radiusValue string, defaultValue float64) float64 {
+	if len(radiusValue) == 0 {
+		return defaultValue
+	}
+	v := convertPercent(radiusValue)
+	if v != -1 {
+		return diameter * v
+	}
+	radius, err := strconv.ParseFloat(radiusValue, 64)
+	if err != nil {
+		return defaultValue
+	}
+	return radius
+}
+
+// lightenColor mixes the color with white
+func lightenColor(c Color, percent float64) Color {
+	if percent <= 0 {
+		return c
+	}
+	if percent > 1 {
+		percent = 1
+	}
+	mix := func(v uint8) uint8 {
+		return v + uint8(float64(255-v)*percent)
+	}
+	return Color{
+		R: mix(c.R),
+		G: mix(c.G),
+		B: mix(c.B),
+		A: c.A,
+	}
+}
+
+type sunburstRing struct {
+	innerRadius float64
+	radius      float64
+	level       SunburstLevel
+}
+
+// sunburstArcTo draws the arc, which is divided into two parts if the delta is larger than pi,
+// because the svg arc can not be drawn if start point is the same as end point
+func sunburstArcTo(p *Painter, cx, cy int, radius, start, delta float64) {
+	if delta > math.Pi {
+		half := delta / 2
+		p.ArcTo(cx, cy, radius, radius, start, half)
+		p.ArcTo(cx, cy, radius, radius, start+half, half)
+		return
+	}
+	p.ArcTo(cx, cy, radius, radius, start, delta)
+}
+
+func (s *sunburstChart) drawSector(p *Painter, sec sector, innerRadius float64) {
+	cx := sec.cx
+	cy := sec.cy
+	if innerRadius <= 0 {
+		p.MoveTo(cx, cy)
+		sunburstArcTo(p, cx, cy, sec.rx, sec.start, sec.delta)
+		p.LineTo(cx, cy).Close().FillStroke()
+		return
+	}
+	center := Point{
+		X: cx,
+		Y: cy,
+	}
+	start := getPolygonPoint(center, innerRadius, sec.start)
+	p.MoveTo(start.X, start.Y)
+	sunburstArcTo(p, cx, cy, sec.rx, sec.start, sec.delta)
+	// 内圈的弧以折线反向绘制(每2度一段)
+	steps := int(sec.delta/chart.DegreesToRadians(2)) + 1
+	for i := steps; i >= 0; i-- {
+		angle := sec.start + sec.delta*float64(i)/float64(steps)
+		point := getPolygonPoint(center, innerRadius, angle)
+		p.LineTo(point.X, point.Y)
+	}
+	p.Close().FillStroke()
+}
+
+func (s *sunburstChart) drawLabel(p *Painter, sec sector, ring sunburstRing) {
+	opt := s.opt
+	if isFalse(ring.level.LabelShow) || sec.label == "" {
+		return
+	}
+	fontColor := ring.level.FontColor
+	if fontColor.IsZero() {
+		if p.isLightColor(sec.color) {
+			fontColor = defaultLightFontColor
+		} else {
+			fontColor = defaultDarkFontColor
+		}
+	}
+	fontSize := ring.level.FontSize
+	if fontSize == 0 {
+		fontSize = labelFontSize
+	}
+	p.OverrideTextStyle(Style{
+		FontColor: fontColor,
+		FontSize:  fontSize,
+		Font:      opt.Font,
+	})
+	p.ClearTextRotation()
+	textBox := p.MeasureText(sec.label)
+	width := float64(textBox.Width())
+	height := float64(textBox.Height())
+
+	angle := sec.start + sec.delta/2
+	midRadius := (ring.innerRadius + ring.radius) / 2
+	ringWidth := ring.radius - ring.innerRadius
+	arcLength := sec.delta * midRadius
+
+	radians := angle
+	if opt.Sunburst.LabelRotate == SunburstLabelRotateTangential {
+		// 文本沿弧线方向
+		if width > arcLength || height > ringWidth {
+			return
+		}
+		radians = angle + math.Pi/2
+		if math.Sin(angle) > 0 {
+			radians = angle - math.Pi/2
+		}
+	} else {
+		// 文本沿半径方向
+		if width > ringWidth || height > arcLength {
+			return
+		}
+		if math.Cos(angle) < 0 {
+			radians = angle + math.Pi
+		}
+	}
+	center := getPolygonPoint(Point{
+		X: sec.cx,
+		Y: sec.cy,
+	}, midRadius, angle)
+	// 以文本中心为旋转后的位置
+	offsetX := width / 2
+	offsetY := height * 0.35
+	x := float64(center.X) - offsetX*math.Cos(radians) - offsetY*math.Sin(radians)
+	y := float64(center.Y) - offsetX*math.Sin(radians) + offsetY*math.Cos(radians)
+	p.TextRotation(sec.label, int(math.Round(x)), int(math.Round(y)), radians)
+}
+
+func (s *sunburstChart) render(result *defaultRenderResult, data []SunburstData) (Box, error) {
+	opt := s.opt
+	theme := opt.Theme
+	total := float64(0)
+	for _, item := range data {
+		total += item.Sum()
+	}
+	if total <= 0 {
+		return BoxZero, errors.New("The sum value of sunburst chart should gt 0")
+	}
+	seriesPainter := result.seriesPainter
+	cx := seriesPainter.Width() >> 1
+	cy := seriesPainter.Height() >> 1
+	diameter := float64(chart.MinInt(seriesPainter.Width(), seriesPainter.Height()))
+
+	innerRadius := parseRadius(diameter, opt.Sunburst.InnerRadius, 0)
+	radius := parseRadius(diameter, opt.Sunburst.Radius, diameter*defaultRadiusPercent)
+	depth := getSunburstDepth(data)
+	ringWidth := (radius - innerRadius) / float64(depth)
+	rings := make([]sunburstRing, depth)
+	for i := range rings {
+		ring := sunburstRing{
+			innerRadius: innerRadius + ringWidth*float64(i),
+			radius:      innerRadius + ringWidth*float64(i+1),
+		}
+		if i < len(opt.Sunburst.Levels) {
+			ring.level = opt.Sunburst.Levels[i]
+			ring.innerRadius = parseRadius(diameter, ring.level.InnerRadius, ring.innerRadius)
+			ring.radius = parseRadius(diameter, ring.level.Radius, ring.radius)
+		}
+		rings[i] = ring
+	}
+
+	type labelSector struct {
+		sector sector
+		ring   sunburstRing
+	}
+	labels := make([]labelSector, 0)
+	var renderLevel func(items []SunburstData, level int, offset float64, parentColor Color)
+	renderLevel = func(items []SunburstData, level int, offset float64, parentColor Color) {
+		for index, item := range items {
+			value := item.Sum()
+			if value <= 0 {
+				continue
+			}
+			color := item.Color
+			if color.IsZero() {
+				if level == 0 {
+					color = theme.GetSeriesColor(index)
+				} else {
+					color = lightenColor(parentColor, 0.2)
+				}
+			}
+			ring := rings[level]
+			sec := NewSector(cx, cy, ring.radius, ring.radius, value, offset, total, 0, item.Name, Series{
+				Label: SeriesLabel{
+					Formatter: "{b}",
+				},
+			}, color)
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeWidth: 1,
+				StrokeColor: theme.GetBackgroundColor(),
+				FillColor:   color,
+			})
+			s.drawSector(seriesPainter, sec, ring.innerRadius)
+			labels = append(labels, labelSector{
+				sector: sec,
+				ring:   ring,
+			})
+			renderLevel(item.Children, level+1, offset, color)
+			offset += value
+		}
+	}
+	renderLevel(data, 0, 0, Color{})
+
+	// 文本最后绘制，避免被覆盖
+	for _, item := range labels {
+		s.drawLabel(seriesPainter, item.sector, item.ring)
+	}
+	seriesPainter.ClearTextRotation()
+
+	return s.p.box, nil
+}
+
+func (s *sunburstChart) Render() (Box, error) {
+	p := s.p
+	opt := s.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:   opt.Theme,
+		Padding: opt.Padding,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption:        opt.Title,
+		LegendOption:       opt.Legend,
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return s.render(renderResult, opt.Sunburst.Data)
+}
@@ -0,0 +1,92 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSunburstChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewSunburstChart(p, SunburstChartOption{
+					Title: TitleOption{
+						Text: "Sunburst",
+					},
+					Sunburst: SunburstOption{
+						InnerRadius: "10%",
+						Data: []SunburstData{
+							{
+								Name: "Infra",
+								Children: []SunburstData{
+									{
+										Name:  "Compute",
+										Value: 30,
+									},
+									{
+										Name:  "Storage",
+										Value: 20,
+									},
+								},
+							},
+							{
+								Name:  "Product",
+								Value: 40,
+								Children: []SunburstData{
+									{
+										Name:  "Search",
+										Value: 25,
+									},
+								},
+							},
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sunburst</text><path  d=\"M 300 169\nL 300 119\nA 83 83 100.00 0 1 382 216\nL 382 216\nA 83 83 100.00 0 1 272 280\nL 289 233\nL 290 233\nL 291 234\nL 292 234\nL 293 234\nL 295 234\nL 296 235\nL 297 235\nL 298 235\nL 299 235\nL 300 235\nL 301 235\nL 302 235\nL 303 235\nL 304 235\nL 305 235\nL 306 234\nL 307 234\nL 309 234\nL 310 233\nL 311 233\nL 312 233\nL 313 232\nL 314 232\nL 315 231\nL 316 231\nL 317 230\nL 318 229\nL 319 229\nL 320 228\nL 321 227\nL 322 227\nL 323 226\nL 323 225\nL 324 224\nL 325 223\nL 326 222\nL 326 222\nL 327 221\nL 328 220\nL 328 219\nL 329 218\nL 329 217\nL 330 216\nL 330 215\nL 331 213\nL 331 212\nL 332 211\nL 332 210\nL 332 209\nL 332 208\nL 333 207\nL 333 206\nL 333 204\nL 333 203\nL 333 202\nL 333 202\nL 333 201\nL 333 200\nL 333 199\nL 333 197\nL 332 196\nL 332 195\nL 332 194\nL 332 193\nL 331 192\nL 331 191\nL 330 190\nL 330 189\nL 329 187\nL 329 186\nL 328 185\nL 328 184\nL 327 183\nL 326 183\nL 326 182\nL 325 181\nL 324 180\nL 323 179\nL 323 178\nL 322 177\nL 321 177\nL 320 176\nL 319 175\nL 318 175\nL 317 174\nL 316 173\nL 315 173\nL 314 172\nL 313 172\nL 312 171\nL 311 171\nL 310 171\nL 309 170\nL 308 170\nL 306 170\nL 305 169\nL 304 169\nL 303 169\nL 302 169\nL 301 169\nL 300 169\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 300 119\nL 300 68\nA 134 134 120.00 0 1 416 268\nL 372 243\nL 373 241\nL 375 238\nL 376 236\nL 377 233\nL 378 230\nL 379 227\nL 380 225\nL 381 222\nL 381 219\nL 382 216\nL 382 213\nL 383 210\nL 383 207\nL 383 204\nL 383 202\nL 383 200\nL 383 197\nL 383 194\nL 382 191\nL 382 188\nL 381 185\nL 381 182\nL 380 179\nL 379 177\nL 378 174\nL 377 171\nL 376 168\nL 375 166\nL 373 163\nL 372 161\nL 371 158\nL 369 156\nL 367 153\nL 365 151\nL 364 149\nL 362 146\nL 360 144\nL 358 142\nL 356 140\nL 353 138\nL 351 137\nL 349 135\nL 346 133\nL 344 131\nL 341 130\nL 339 129\nL 336 127\nL 334 126\nL 331 125\nL 328 124\nL 325 123\nL 323 122\nL 320 121\nL 317 121\nL 314 120\nL 311 120\nL 308 119\nL 305 119\nL 302 119\nL 300 119\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(118,140,209,1.0)\"/><path  d=\"M 372 243\nL 416 268\nA 134 134 80.00 0 1 255 327\nL 272 280\nL 275 281\nL 277 282\nL 280 283\nL 283 283\nL 286 284\nL 288 284\nL 291 285\nL 294 285\nL 297 285\nL 300 285\nL 302 285\nL 304 285\nL 307 285\nL 310 285\nL 313 284\nL 316 284\nL 319 283\nL 321 282\nL 324 282\nL 327 281\nL 329 280\nL 332 279\nL 335 277\nL 337 276\nL 340 275\nL 342 273\nL 345 272\nL 347 270\nL 349 269\nL 352 267\nL 354 265\nL 356 263\nL 358 261\nL 360 259\nL 362 257\nL 364 255\nL 366 253\nL 367 251\nL 369 248\nL 371 246\nL 372 243\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(118,140,209,1.0)\"/><path  d=\"M 289 233\nL 272 280\nA 83 83 160.00 0 1 300 119\nL 300 169\nL 299 169\nL 298 169\nL 297 169\nL 296 169\nL 295 169\nL 294 170\nL 292 170\nL 291 170\nL 290 171\nL 289 171\nL 288 171\nL 287 172\nL 286 172\nL 285 173\nL 284 173\nL 283 174\nL 282 175\nL 281 175\nL 280 176\nL 279 177\nL 278 177\nL 277 178\nL 277 179\nL 276 180\nL 275 181\nL 274 182\nL 274 182\nL 273 183\nL 272 184\nL 272 185\nL 271 186\nL 271 187\nL 270 188\nL 270 189\nL 269 191\nL 269 192\nL 268 193\nL 268 194\nL 268 195\nL 268 196\nL 267 197\nL 267 198\nL 267 200\nL 267 201\nL 267 202\nL 267 202\nL 267 203\nL 267 204\nL 267 205\nL 267 207\nL 268 208\nL 268 209\nL 268 210\nL 268 211\nL 269 212\nL 269 213\nL 270 214\nL 270 215\nL 271 216\nL 271 217\nL 272 218\nL 272 219\nL 273 220\nL 274 221\nL 274 222\nL 275 223\nL 276 224\nL 277 225\nL 277 226\nL 278 227\nL 279 227\nL 280 228\nL 281 229\nL 282 229\nL 283 230\nL 284 231\nL 285 231\nL 286 232\nL 287 232\nL 288 233\nL 289 233\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 272 280\nL 255 327\nA 134 134 100.00 0 1 184 135\nL 228 161\nL 227 163\nL 225 166\nL 224 168\nL 223 171\nL 222 174\nL 221 176\nL 220 179\nL 219 182\nL 219 185\nL 218 187\nL 218 190\nL 217 193\nL 217 196\nL 217 199\nL 217 202\nL 217 204\nL 217 206\nL 217 209\nL 217 212\nL 218 215\nL 218 218\nL 219 221\nL 220 223\nL 220 226\nL 221 229\nL 222 231\nL 223 234\nL 225 237\nL 226 239\nL 227 242\nL 229 244\nL 230 247\nL 232 249\nL 233 252\nL 235 254\nL 237 256\nL 239 258\nL 241 260\nL 243 262\nL 245 264\nL 247 266\nL 249 268\nL 252 270\nL 254 271\nL 256 273\nL 259 274\nL 261 276\nL 264 277\nL 267 278\nL 269 279\nL 272 280\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(167,214,144,1.0)\"/><text x=\"343\" y=\"214\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(10.00,343,214)\">Infra</text><text x=\"325\" y=\"284\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(70.00,325,284)\">Storage</text><text x=\"220\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(10.00,220,192)\">Product</text><text x=\"181\" y=\"250\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(340.00,181,250)\">Search</text></svg>",
+		},
+	}
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
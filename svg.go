@@ -0,0 +1,574 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"golang.org/x/image/font"
+)
+
+// svgAttribute is the attribute of svg element
+type svgAttribute struct {
+	Name  string
+	Value string
+}
+
+func (attr svgAttribute) String() string {
+	return fmt.Sprintf(`%s="%s"`, attr.Name, html.EscapeString(attr.Value))
+}
+
+// svgClassName is the class name of root element for semantic svg
+const svgClassName = "go-charts"
+
+type SVGOption struct {
+	// Group the elements by component with class names, e.g. title, legend, x-axis,
+	// y-axis, split-line, series series-0, mark-line, mark-point and label
+	Semantic bool
+	// Output a stylesheet which defines the theme colors as css custom properties,
+	// e.g. --chart-text-color and --chart-series-color-0, the colors of elements
+	// reference them, so the chart can be restyled without rendering again
+	Stylesheet bool
+}
+
+// getSVGRenderer returns the svg renderer of painter
+func (p *Painter) getSVGRenderer() *svgRenderer {
+	r, _ := p.render.(*svgRenderer)
+	return r
+}
+
+// setSVGOption sets the semantic and stylesheet option of svg
+func (p *Painter) setSVGOption(opt SVGOption, theme ColorPalette, seriesCount int) {
+	r := p.getSVGRenderer()
+	if r == nil || (!opt.Semantic && !opt.Stylesheet) {
+		return
+	}
+	r.semantic = opt.Semantic
+	r.attributes = append(r.attributes, svgAttribute{
+		Name:  "class",
+		Value: svgClassName,
+	})
+	if !opt.Stylesheet {
+		return
+	}
+	for i := 0; i < seriesCount; i++ {
+		r.setColorVar(fmt.Sprintf("--chart-series-color-%d", i), theme.GetSeriesColor(i))
+	}
+	r.setColorVar("--chart-text-color", theme.GetTextColor())
+	r.setColorVar("--chart-axis-stroke-color", theme.GetAxisStrokeColor())
+	r.setColorVar("--chart-axis-split-line-color", theme.GetAxisSplitLineColor())
+	r.setColorVar("--chart-background-color", theme.GetBackgroundColor())
+}
+
+// startGroup starts a group of the following drawing, it only works for accessible or semantic svg
+func (p *Painter) startGroup(attrs ...svgAttribute) *Painter {
+	if r := p.getSVGRenderer(); r != nil {
+		r.startGroup(attrs...)
+	}
+	return p
+}
+
+// endGroup ends the group
+func (p *Painter) endGroup() *Painter {
+	if r := p.getSVGRenderer(); r != nil {
+		r.endGroup()
+	}
+	return p
+}
+
+// startComponent starts the group of component for semantic svg
+func (p *Painter) startComponent(className string) *Painter {
+	if r := p.getSVGRenderer(); r != nil && r.semantic {
+		r.startGroup(svgAttribute{
+			Name:  "class",
+			Value: className,
+		})
+	}
+	return p
+}
+
+// endComponent ends the group of component
+func (p *Painter) endComponent() *Painter {
+	if r := p.getSVGRenderer(); r != nil && r.semantic {
+		r.endGroup()
+	}
+	return p
+}
+
+// startSeriesGroup starts the group of series
+func (p *Painter) startSeriesGroup(series Series) *Painter {
+	r := p.getSVGRenderer()
+	if r == nil {
+		return p
+	}
+	attrs := make([]svgAttribute, 0, 3)
+	if r.semantic {
+		attrs = append(attrs, svgAttribute{
+			Name:  "class",
+			Value: fmt.Sprintf("series series-%d", series.index),
+		})
+	}
+	if r.accessible {
+		attrs = append(attrs, svgAttribute{
+			Name:  "role",
+			Value: "group",
+		}, svgAttribute{
+			Name:  "aria-label",
+			Value: getAccessibleName(series),
+		})
+	}
+	r.startGroup(attrs...)
+	return p
+}
+
+// svgRenderer renders chart commands to svg, the output is the same as
+// the svg renderer of go-chart, but it supports groups and accessible content
+type svgRenderer struct {
+	dpi       float64
+	width     int
+	height    int
+	buf       bytes.Buffer
+	s         *chart.Style
+	p         []string
+	textTheta *float64
+	// 无障碍及语义化输出分组
+	accessible bool
+	semantic   bool
+	// 输出为html，添加提示及图例切换
+	interactive bool
+	htmlTitle   string
+	// 未输出的分组，在有元素输出时才输出
+	pendingGroups []string
+	groupDepth    int
+	// 颜色对应的css变量
+	colorVars     map[string]string
+	colorVarNames []string
+	// 根节点的属性
+	attributes  []svgAttribute
+	title       string
+	description string
+	// 追加在最后的内容，如隐藏的数据表格
+	appendix string
+	// 渐变填充及已输出的渐变
+	fillGradient *Gradient
+	gradientID   string
+	gradientIDs  map[string]string
+}
+
+// newSVGRenderer returns a new svg renderer
+func newSVGRenderer(width, height int) (chart.Renderer, error) {
+	return &svgRenderer{
+		dpi:    chart.DefaultDPI,
+		width:  width,
+		height: height,
+		s:      &chart.Style{},
+	}, nil
+}
+
+func (vr *svgRenderer) groupEnabled() bool {
+	return vr.accessible || vr.semantic
+}
+
+// startGroup starts a group element if group is enabled,
+// the group is written when the first element of it is drawn
+func (vr *svgRenderer) startGroup(attrs ...svgAttribute) {
+	if !vr.groupEnabled() {
+		return
+	}
+	values := make([]string, 0, len(attrs)+1)
+	values = append(values, "<g")
+	for _, attr := range attrs {
+		values = append(values, attr.String())
+	}
+	vr.pendingGroups = append(vr.pendingGroups, strings.Join(values, " ")+">")
+}
+
+// endGroup ends the group element, the empty group is ignored
+func (vr *svgRenderer) endGroup() {
+	if !vr.groupEnabled() {
+		return
+	}
+	if len(vr.pendingGroups) != 0 {
+		vr.pendingGroups = vr.pendingGroups[:len(vr.pendingGroups)-1]
+		return
+	}
+	if vr.groupDepth == 0 {
+		return
+	}
+	vr.buf.WriteString("</g>")
+	vr.groupDepth--
+}
+
+// write writes the element after the pending groups
+func (vr *svgRenderer) write(element string) {
+	for _, group := range vr.pendingGroups {
+		vr.buf.WriteString(group)
+		vr.groupDepth++
+	}
+	vr.pendingGroups = vr.pendingGroups[:0]
+	vr.buf.WriteString(element)
+}
+
+// setColorVar sets the css custom property of color,
+// the first property is used if the colors are the same
+func (vr *svgRenderer) setColorVar(name string, c drawing.Color) {
+	if c.IsZero() {
+		return
+	}
+	if vr.colorVars == nil {
+		vr.colorVars = make(map[string]string)
+	}
+	value := c.String()
+	if _, ok := vr.colorVars[value]; ok {
+		return
+	}
+	vr.colorVars[value] = name
+	vr.colorVarNames = append(vr.colorVarNames, name+":"+value)
+}
+
+// colorAsSVG returns the color value, which references the css custom property if exists
+func (vr *svgRenderer) colorAsSVG(c drawing.Color) string {
+	value := c.String()
+	if name, ok := vr.colorVars[value]; ok {
+		return fmt.Sprintf("var(%s,%s)", name, value)
+	}
+	return value
+}
+
+func (vr *svgRenderer) ResetStyle() {
+	vr.s = &chart.Style{Font: vr.s.Font}
+	vr.fillGradient = nil
+}
+
+func (vr *svgRenderer) setFillGradient(g *Gradient) {
+	vr.fillGradient = g
+}
+
+// writeGradient writes the definition of fill gradient if it is not written,
+// the gradient is only used to replace the fill color
+func (vr *svgRenderer) writeGradient() {
+	vr.gradientID = ""
+	g := vr.fillGradient
+	if g == nil || vr.s.FillColor.IsZero() || vr.s.ClassName != "" {
+		return
+	}
+	key := g.String()
+	if id, ok := vr.gradientIDs[key]; ok {
+		vr.gradientID = id
+		return
+	}
+	if vr.gradientIDs == nil {
+		vr.gradientIDs = make(map[string]string)
+	}
+	id := fmt.Sprintf("gradient-%d", len(vr.gradientIDs))
+	vr.gradientIDs[key] = id
+	vr.gradientID = id
+
+	format := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	values := make([]string, 0, len(g.Stops)+2)
+	if g.IsRadial() {
+		values = append(values, fmt.Sprintf(`<defs><radialGradient id="%s" cx="%s" cy="%s" r="%s">`, id, format(g.X), format(g.Y), format(g.R)))
+	} else {
+		values = append(values, fmt.Sprintf(`<defs><linearGradient id="%s" x1="%s" y1="%s" x2="%s" y2="%s">`, id, format(g.X), format(g.Y), format(g.X2), format(g.Y2)))
+	}
+	for _, stop := range g.getStops() {
+		c := stop.Color
+		values = append(values, fmt.Sprintf(`<stop offset="%s" stop-color="rgb(%d,%d,%d)" stop-opacity="%s"/>`, format(stop.Offset), c.R, c.G, c.B, format(math.Round(float64(c.A)/255*100)/100)))
+	}
+	if g.IsRadial() {
+		values = append(values, "</radialGradient></defs>")
+	} else {
+		values = append(values, "</linearGradient></defs>")
+	}
+	vr.write(strings.Join(values, ""))
+}
+
+func (vr *svgRenderer) GetDPI() float64 {
+	return vr.dpi
+}
+
+func (vr *svgRenderer) SetDPI(dpi float64) {
+	vr.dpi = dpi
+}
+
+func (vr *svgRenderer) SetClassName(className string) {
+	vr.s.ClassName = className
+}
+
+func (vr *svgRenderer) SetStrokeColor(c drawing.Color) {
+	vr.s.StrokeColor = c
+}
+
+func (vr *svgRenderer) SetFillColor(c drawing.Color) {
+	vr.s.FillColor = c
+}
+
+func (vr *svgRenderer) SetStrokeWidth(width float64) {
+	vr.s.StrokeWidth = width
+}
+
+func (vr *svgRenderer) SetStrokeDashArray(dashArray []float64) {
+	vr.s.StrokeDashArray = dashArray
+}
+
+func (vr *svgRenderer) MoveTo(x, y int) {
+	vr.p = append(vr.p, fmt.Sprintf("M %d %d", x, y))
+}
+
+func (vr *svgRenderer) LineTo(x, y int) {
+	vr.p = append(vr.p, fmt.Sprintf("L %d %d", x, y))
+}
+
+func (vr *svgRenderer) QuadCurveTo(cx, cy, x, y int) {
+	vr.p = append(vr.p, fmt.Sprintf("Q%d,%d %d,%d", cx, cy, x, y))
+}
+
+func (vr *svgRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	startAngle = chart.RadianAdd(startAngle, math.Pi/2)
+	endAngle := chart.RadianAdd(startAngle, delta)
+
+	startx := cx + int(rx*math.Sin(startAngle))
+	starty := cy - int(ry*math.Cos(startAngle))
+
+	if len(vr.p) > 0 {
+		vr.p = append(vr.p, fmt.Sprintf("L %d %d", startx, starty))
+	} else {
+		vr.p = append(vr.p, fmt.Sprintf("M %d %d", startx, starty))
+	}
+
+	endx := cx + int(rx*math.Sin(endAngle))
+	endy := cy - int(ry*math.Cos(endAngle))
+
+	dd := chart.RadiansToDegrees(delta)
+
+	largeArcFlag := 0
+	if delta > math.Pi {
+		largeArcFlag = 1
+	}
+
+	vr.p = append(vr.p, fmt.Sprintf("A %d %d %0.2f %d 1 %d %d", int(rx), int(ry), dd, largeArcFlag, endx, endy))
+}
+
+func (vr *svgRenderer) Close() {
+	vr.p = append(vr.p, "Z")
+}
+
+func (vr *svgRenderer) Stroke() {
+	vr.drawPath()
+}
+
+func (vr *svgRenderer) Fill() {
+	vr.drawPath()
+}
+
+func (vr *svgRenderer) FillStroke() {
+	vr.drawPath()
+}
+
+// drawPath draws the path with fill and stroke style, and clears the path
+func (vr *svgRenderer) drawPath() {
+	style := vr.s.GetFillAndStrokeOptions()
+	strokeDashArray := ""
+	if len(style.StrokeDashArray) > 0 {
+		values := make([]string, len(style.StrokeDashArray))
+		for index, v := range style.StrokeDashArray {
+			values[index] = fmt.Sprintf("%0.1f", v)
+		}
+		strokeDashArray = `stroke-dasharray="` + strings.Join(values, ", ") + `"`
+	}
+	vr.writeGradient()
+	vr.write(fmt.Sprintf(`<path %s d="%s" %s/>`, strokeDashArray, strings.Join(vr.p, "\n"), vr.styleAsSVG(style)))
+	vr.p = []string{}
+}
+
+func (vr *svgRenderer) Circle(radius float64, x, y int) {
+	vr.writeGradient()
+	vr.write(fmt.Sprintf(`<circle cx="%d" cy="%d" r="%d" %s/>`, x, y, int(radius), vr.styleAsSVG(vr.s.GetFillAndStrokeOptions())))
+}
+
+func (vr *svgRenderer) SetFont(f *truetype.Font) {
+	vr.s.Font = f
+}
+
+func (vr *svgRenderer) SetFontColor(c drawing.Color) {
+	vr.s.FontColor = c
+}
+
+func (vr *svgRenderer) SetFontSize(size float64) {
+	vr.s.FontSize = size
+}
+
+func (vr *svgRenderer) Text(body string, x, y int) {
+	style := vr.styleAsSVG(vr.s.GetTextOptions())
+	// 文本需转义，避免破坏文档结构或注入脚本
+	body = html.EscapeString(body)
+	if vr.textTheta == nil {
+		vr.write(fmt.Sprintf(`<text x="%d" y="%d" %s>%s</text>`, x, y, style, body))
+		return
+	}
+	transform := fmt.Sprintf(` transform="rotate(%0.2f,%d,%d)"`, chart.RadiansToDegrees(*vr.textTheta), x, y)
+	vr.write(fmt.Sprintf(`<text x="%d" y="%d" %s%s>%s</text>`, x, y, style, transform, body))
+}
+
+// MeasureText uses the truetype font drawer to measure the width of text
+func (vr *svgRenderer) MeasureText(body string) (box chart.Box) {
+	f := vr.s.GetFont()
+	if f == nil {
+		return
+	}
+	fc := &font.Drawer{
+		Face: truetype.NewFace(f, &truetype.Options{
+			DPI:  vr.dpi,
+			Size: vr.s.FontSize,
+		}),
+	}
+	box.Right = fc.MeasureString(body).Ceil()
+	box.Bottom = int(drawing.PointsToPixels(vr.dpi, vr.s.FontSize))
+	if vr.textTheta == nil {
+		return
+	}
+	return box.Corners().Rotate(chart.RadiansToDegrees(*vr.textTheta)).Box()
+}
+
+func (vr *svgRenderer) SetTextRotation(radians float64) {
+	vr.textTheta = &radians
+}
+
+func (vr *svgRenderer) ClearTextRotation() {
+	vr.textTheta = nil
+}
+
+// Save writes the svg to the writer
+func (vr *svgRenderer) Save(w io.Writer) error {
+	buf := bytes.Buffer{}
+	attrs := []string{
+		`xmlns="http://www.w3.org/2000/svg"`,
+		`xmlns:xlink="http://www.w3.org/1999/xlink"`,
+		fmt.Sprintf(`width="%d"`, vr.width),
+		fmt.Sprintf(`height="%d"`, vr.height),
+	}
+	for _, attr := range vr.attributes {
+		attrs = append(attrs, attr.String())
+	}
+	buf.WriteString(fmt.Sprintf(`<svg %s>\n`, strings.Join(attrs, " ")))
+	if vr.title != "" {
+		buf.WriteString("<title>" + html.EscapeString(vr.title) + "</title>")
+	}
+	if vr.description != "" {
+		buf.WriteString("<desc>" + html.EscapeString(vr.description) + "</desc>")
+	}
+	if len(vr.colorVarNames) != 0 {
+		buf.WriteString(fmt.Sprintf(`<style type="text/css"><![CDATA[.%s{%s}]]></style>`, svgClassName, strings.Join(vr.colorVarNames, ";")))
+	}
+	buf.Write(vr.buf.Bytes())
+	// 未结束的分组
+	for i := 0; i < vr.groupDepth; i++ {
+		buf.WriteString("</g>")
+	}
+	buf.WriteString(vr.appendix)
+	buf.WriteString("</svg>")
+	if vr.interactive {
+		return vr.saveHTML(w, buf.Bytes())
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (vr *svgRenderer) getFontFace(s chart.Style) string {
+	family := "sans-serif"
+	if s.GetFont() != nil {
+		name := s.GetFont().Name(truetype.NameIDFontFamily)
+		if len(name) != 0 {
+			family = fmt.Sprintf(`'%s',%s`, name, family)
+		}
+	}
+	return fmt.Sprintf("font-family:%s", family)
+}
+
+// styleAsSVG returns the style as a svg style or class string
+func (vr *svgRenderer) styleAsSVG(s chart.Style) string {
+	sw := s.StrokeWidth
+	sc := s.StrokeColor
+	fc := s.FillColor
+	fs := s.FontSize
+	fnc := s.FontColor
+
+	if s.ClassName != "" {
+		classes := []string{
+			s.ClassName,
+		}
+		if !sc.IsZero() {
+			classes = append(classes, "stroke")
+		}
+		if !fc.IsZero() {
+			classes = append(classes, "fill")
+		}
+		if fs != 0 || s.Font != nil {
+			classes = append(classes, "text")
+		}
+		return fmt.Sprintf(`class="%s"`, strings.Join(classes, " "))
+	}
+
+	var pieces []string
+	if sw != 0 {
+		pieces = append(pieces, "stroke-width:"+fmt.Sprintf("%d", int(sw)))
+	} else {
+		pieces = append(pieces, "stroke-width:0")
+	}
+
+	if !sc.IsZero() {
+		pieces = append(pieces, "stroke:"+vr.colorAsSVG(sc))
+	} else {
+		pieces = append(pieces, "stroke:none")
+	}
+
+	if !fnc.IsZero() {
+		pieces = append(pieces, "fill:"+vr.colorAsSVG(fnc))
+	} else if !fc.IsZero() && vr.gradientID != "" {
+		pieces = append(pieces, "fill:url(#"+vr.gradientID+")")
+	} else if !fc.IsZero() {
+		pieces = append(pieces, "fill:"+vr.colorAsSVG(fc))
+	} else {
+		pieces = append(pieces, "fill:none")
+	}
+
+	if fs != 0 {
+		pieces = append(pieces, "font-size:"+fmt.Sprintf("%.1fpx", drawing.PointsToPixels(vr.dpi, fs)))
+	}
+
+	if s.Font != nil {
+		pieces = append(pieces, vr.getFontFace(s))
+	}
+	return fmt.Sprintf(`style="%s"`, strings.Join(pieces, ";"))
+}
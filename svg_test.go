@@ -0,0 +1,114 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func TestSVGRendererGroup(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  100,
+		Height: 50,
+	}, PainterThemeOption(defaultTheme))
+	assert.Nil(err)
+	theme := NewTheme(ThemeLight)
+	p.setSVGOption(SVGOption{
+		Semantic:   true,
+		Stylesheet: true,
+	}, theme, 1)
+
+	// 空的分组不输出
+	p.startComponent("title")
+	p.endComponent()
+
+	p.startSeriesGroup(Series{})
+	p.startComponent("label")
+	p.SetDrawingStyle(Style{
+		StrokeColor: theme.GetSeriesColor(0),
+		StrokeWidth: 1,
+	})
+	p.LineStroke([]Point{
+		{
+			X: 0,
+			Y: 0,
+		},
+		{
+			X: 10,
+			Y: 10,
+		},
+	})
+	p.endComponent()
+	p.SetTextStyle(Style{
+		FontColor: drawing.ColorBlack,
+	})
+	p.Text("A", 0, 10)
+	p.endGroup()
+
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="100" height="50" class="go-charts">\n<style type="text/css"><![CDATA[.go-charts{--chart-series-color-0:rgba(84,112,198,1.0);--chart-text-color:rgba(70,70,70,1.0);--chart-axis-stroke-color:rgba(110,112,121,1.0);--chart-axis-split-line-color:rgba(224,230,242,1.0);--chart-background-color:rgba(255,255,255,1.0)}]]></style><g class="series series-0"><g class="label"><path  d="M 0 0
+L 10 10" style="stroke-width:1;stroke:var(--chart-series-color-0,rgba(84,112,198,1.0));fill:none"/></g><text x="0" y="10" style="stroke-width:0;stroke:none;fill:rgba(0,0,0,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif">A</text></g></svg>`, string(buf))
+}
+
+func TestChartSemanticSVG(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := LineRender([][]float64{
+		{120, 132, 101},
+	},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Traffic"),
+		XAxisDataOptionFunc([]string{"Mon", "Tue", "Wed"}),
+		LegendLabelsOptionFunc([]string{"Email"}),
+		func(opt *ChartOption) {
+			opt.SVG.Semantic = true
+		},
+	)
+	assert.Nil(err)
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	data := string(buf)
+	for _, className := range []string{
+		"background",
+		"title",
+		"legend",
+		"axis x-axis",
+		"axis y-axis",
+		"split-line",
+		"series series-0",
+	} {
+		assert.Equal(1, strings.Count(data, `<g class="`+className+`">`), className)
+	}
+	assert.Equal(strings.Count(data, "<g "), strings.Count(data, "</g>"))
+	// 未启用stylesheet则不引用变量
+	assert.NotContains(data, "var(")
+	assert.NotContains(data, "<style")
+}
@@ -23,7 +23,11 @@
 package charts
 
 import (
+	"encoding/json"
 	"errors"
+	"math"
+	"strconv"
+	"strings"
 
 	"github.com/golang/freetype/truetype"
 	"github.com/wcharczuk/go-chart/v2"
@@ -57,9 +61,175 @@ type TableCell struct {
 	Column int
 }
 
+const (
+	TableIconCircle    = "circle"
+	TableIconRect      = "rect"
+	TableIconArrowUp   = "arrowUp"
+	TableIconArrowDown = "arrowDown"
+)
+
+type TableCellContent struct {
+	// The chart option of cell, the chart is rendered in the cell box
+	Chart *ChartOption
+	// The values of sparkline
+	Sparkline []float64
+	// The progress of cell, it should be in [0, 1]
+	Progress *float64
+	// The icon of cell, it is rendered in front of the text.
+	// It can be "circle", "rect", "arrowUp" or "arrowDown"
+	Icon string
+	// The color of progress bar or icon
+	Color Color
+	// The height of chart, sparkline or progress bar.
+	// Default is the height of one line text
+	Height int
+}
+
+const (
+	TableOverflowWrap     = "wrap"
+	TableOverflowEllipsis = "ellipsis"
+	TableOverflowShrink   = "shrink"
+)
+
+const tableIconMargin = 5
+
+// The width of chart, sparkline or progress bar when the column width fits the content
+const tableBlockContentWidth = 100
+
+type TableHeaderGroup struct {
+	// The text of header group, the empty group is merged with the header cell below
+	Text string
+	// The column count of header group, default is 1
+	Span int
+}
+
+type TableMergedCell struct {
+	// The row index of merged cell, the header row is 0
+	Row int
+	// The column index of merged cell
+	Column int
+	// The row count of merged cell, default is 1
+	RowSpan int
+	// The column count of merged cell, default is 1
+	ColSpan int
+}
+
+type TableConditionalFormat struct {
+	// The column index list of rule, all columns are matched if it is empty
+	Columns []int `json:"columns"`
+	// The minimum value of cell(inclusive), nil means no limit
+	Min *float64 `json:"min"`
+	// The maximum value of cell(exclusive), nil means no limit
+	Max *float64 `json:"max"`
+	// The font color of matched cell
+	FontColor Color `json:"fontColor"`
+	// The background color of matched cell
+	BackgroundColor Color `json:"backgroundColor"`
+}
+
+// MarshalJSON marshals the colors as "#rrggbb" or "rgba(r,g,b,a)" string
+func (f TableConditionalFormat) MarshalJSON() ([]byte, error) {
+	type alias TableConditionalFormat
+	return json.Marshal(struct {
+		alias
+		FontColor       string `json:"fontColor,omitempty"`
+		BackgroundColor string `json:"backgroundColor,omitempty"`
+	}{
+		alias:           alias(f),
+		FontColor:       formatColor(f.FontColor),
+		BackgroundColor: formatColor(f.BackgroundColor),
+	})
+}
+
+// UnmarshalJSON unmarshals the format, the color can be a string
+// which is parsed by parseColor, or an object of rgba
+func (f *TableConditionalFormat) UnmarshalJSON(data []byte) error {
+	type alias TableConditionalFormat
+	v := struct {
+		*alias
+		FontColor       json.RawMessage `json:"fontColor"`
+		BackgroundColor json.RawMessage `json:"backgroundColor"`
+	}{
+		alias: (*alias)(f),
+	}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	f.FontColor, err = unmarshalColor(v.FontColor)
+	if err != nil {
+		return err
+	}
+	f.BackgroundColor, err = unmarshalColor(v.BackgroundColor)
+	return err
+}
+
+// Match returns true if the cell matches the rule, the text of cell
+// should be a number, the comma and percent sign are ignored
+func (f *TableConditionalFormat) Match(cell TableCell) bool {
+	if len(f.Columns) != 0 && !containsInt(f.Columns, cell.Column) {
+		return false
+	}
+	value, ok := parseTableNumber(cell.Text)
+	if !ok {
+		return false
+	}
+	if f.Min != nil && value < *f.Min {
+		return false
+	}
+	if f.Max != nil && value >= *f.Max {
+		return false
+	}
+	return true
+}
+
+// parseTableNumber parses the number of table cell text
+func parseTableNumber(text string) (float64, bool) {
+	text = strings.TrimSpace(text)
+	text = strings.TrimSuffix(text, "%")
+	text = strings.ReplaceAll(text, ",", "")
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// splitDecimal splits the text into integer part and decimal part(with point)
+func splitDecimal(text string) (string, string) {
+	index := strings.LastIndex(text, ".")
+	if index == -1 {
+		return text, ""
+	}
+	return text[:index], text[index:]
+}
+
+// ellipsisText truncates the text to fit the width
+func ellipsisText(p *Painter, text string, width int) string {
+	if p.MeasureText(text).Width() < width {
+		return text
+	}
+	ellipsis := "..."
+	runes := []rune(text)
+	for i := len(runes) - 1; i > 0; i-- {
+		value := strings.TrimSpace(string(runes[:i])) + ellipsis
+		if p.MeasureText(value).Width() < width {
+			return value
+		}
+	}
+	return ellipsis
+}
+
+// isBlock returns true if the content fills the cell box
+func (c *TableCellContent) isBlock() bool {
+	return c.Chart != nil || len(c.Sparkline) != 0 || c.Progress != nil
+}
+
 type TableChartOption struct {
 	// The output type
 	Type string
+	// The device pixel ratio of raster output
+	PixelRatio float64
 	// The width of table
 	Width int
 	// The theme
@@ -72,8 +242,26 @@ type TableChartOption struct {
 	Data [][]string
 	// The span list of table column
 	Spans []int
-	// The text align list of table cell
+	// The flag for fitting column width to the content, the spans are ignored if it is true.
+	// The width of table is the sum of column widths if the width is not set
+	FitColumnWidth bool
+	// The max height of each page, it is used by TableOptionRenderPages
+	MaxHeight int
+	// The max row count of each page, it is used by TableOptionRenderPages
+	RowsPerPage int
+	// The header groups of table, from top to bottom.
+	// The row index of header group cell is negative, -1 is the group above the header
+	HeaderGroups [][]TableHeaderGroup
+	// The merged cells of table, the text of first cell is rendered
+	MergedCells []TableMergedCell
+	// The text align list of table cell, "decimal" aligns the decimal point of numbers
 	TextAligns []string
+	// The vertical align list of table cell, it can be "top", "middle" or "bottom"
+	VerticalAligns []string `json:"verticalAligns"`
+	// The overflow list of table column, it can be "wrap", "ellipsis" or "shrink", default is wrap
+	Overflows []string `json:"overflows"`
+	// The conditional formats of table data, the first matched rule is used
+	ConditionalFormats []TableConditionalFormat `json:"conditionalFormats"`
 	// The font size of table
 	FontSize float64
 	// The font family, which should be installed first
@@ -89,10 +277,21 @@ type TableChartOption struct {
 	RowBackgroundColors []Color
 	// The background color
 	BackgroundColor Color
+	// The color of grid line, the grid line is not rendered if it is not set
+	GridLineColor Color
 	// CellTextStyle customize text style of table cell
 	CellTextStyle func(TableCell) *Style
 	// CellStyle customize drawing style of table cell
 	CellStyle func(TableCell) *Style
+	// CellContent customize rich content of table cell,
+	// the text of cell is not rendered if the content is chart, sparkline or progress bar
+	CellContent func(TableCell) *TableCellContent
+	// The row offset of data, it is set for pagination
+	rowOffset int
+	// The column widths and data row heights measured with all data,
+	// they are set for pagination to keep the same layout of pages
+	columnWidths []int
+	rowHeights   []int
 }
 
 type TableSetting struct {
@@ -198,9 +397,231 @@ type renderInfo struct {
 	HeaderHeight int
 	RowHeights   []int
 	ColumnWidths []int
+	// The width of table which fits the content
+	ContentWidth int
+}
+
+type tableLayoutCell struct {
+	TableCell
+	// The index of row in table, header groups are included
+	row     int
+	rowSpan int
+	colSpan int
+	isGroup bool
+	content *TableCellContent
+	box     Box
+	// The text to render, it may be truncated
+	text       string
+	textHeight int
+	fontSize   float64
+	decimal    bool
+	format     *TableConditionalFormat
+}
+
+type tableLayout struct {
+	cells          []*tableLayoutCell
+	rowHeights     []int
+	headerRowCount int
+	fontSize       float64
+	padding        Box
+	info           *renderInfo
+	// The max width of decimal part for each column
+	decimalWidths []int
+}
+
+// getTableColumnOption returns the option of column, it returns empty string if not set
+func getTableColumnOption(values []string, column int) string {
+	if len(values) <= column {
+		return ""
+	}
+	return values[column]
 }
 
-func (t *tableChart) render() (*renderInfo, error) {
+// newTableCellGrid returns the cells of table, the header groups and merged cells are
+// converted to cells with row span and column span
+func (t *tableChart) newTableCellGrid(headerStyle, style Style) ([]*tableLayoutCell, int) {
+	opt := t.opt
+	columnCount := len(opt.Header)
+	groupCount := len(opt.HeaderGroups)
+	headerRowCount := groupCount + 1
+	rowCount := headerRowCount + len(opt.Data)
+	covered := make([][]bool, rowCount)
+	for i := range covered {
+		covered[i] = make([]bool, columnCount)
+	}
+	cells := make([]*tableLayoutCell, 0)
+	// 表头分组中空文本的单元格与下一级合并
+	emptyGroups := make([][]bool, groupCount)
+	for i, groups := range opt.HeaderGroups {
+		emptyGroups[i] = make([]bool, columnCount)
+		column := 0
+		for _, group := range groups {
+			span := chart.MaxInt(group.Span, 1)
+			for j := column; j < column+span && j < columnCount; j++ {
+				emptyGroups[i][j] = group.Text == ""
+			}
+			column += span
+		}
+		for j := column; j < columnCount; j++ {
+			emptyGroups[i][j] = true
+		}
+	}
+	headerTop := make([]int, columnCount)
+	for j := range headerTop {
+		headerTop[j] = groupCount
+		for i := groupCount - 1; i >= 0 && emptyGroups[i][j]; i-- {
+			headerTop[j] = i
+		}
+	}
+	for i, groups := range opt.HeaderGroups {
+		column := 0
+		for _, group := range groups {
+			if column >= columnCount {
+				break
+			}
+			span := chart.MinInt(chart.MaxInt(group.Span, 1), columnCount-column)
+			// 空文本的分组按列拆分，已被表头合并的列忽略
+			if group.Text == "" {
+				for j := column; j < column+span; j++ {
+					if headerTop[j] > i {
+						cells = append(cells, &tableLayoutCell{
+							TableCell: TableCell{
+								Row:    i - groupCount,
+								Column: j,
+								Style:  headerStyle,
+							},
+							row:     i,
+							rowSpan: 1,
+							colSpan: 1,
+							isGroup: true,
+						})
+					}
+				}
+			} else {
+				cells = append(cells, &tableLayoutCell{
+					TableCell: TableCell{
+						Text:   group.Text,
+						Row:    i - groupCount,
+						Column: column,
+						Style:  headerStyle,
+					},
+					row:     i,
+					rowSpan: 1,
+					colSpan: span,
+					isGroup: true,
+				})
+			}
+			column += span
+		}
+	}
+
+	type mergedSpan struct {
+		rowSpan int
+		colSpan int
+	}
+	mergedSpans := make(map[[2]int]mergedSpan)
+	for _, item := range opt.MergedCells {
+		rowSpan := chart.MaxInt(item.RowSpan, 1)
+		// 表头不可与内容合并
+		if item.Row == 0 {
+			rowSpan = 1
+		}
+		mergedSpans[[2]int{item.Row, item.Column}] = mergedSpan{
+			rowSpan: rowSpan,
+			colSpan: chart.MaxInt(item.ColSpan, 1),
+		}
+	}
+	textRows := append([][]string{
+		opt.Header,
+	}, opt.Data...)
+	for rowIndex, textList := range textRows {
+		row := groupCount + rowIndex
+		currentStyle := style
+		if rowIndex == 0 {
+			currentStyle = headerStyle
+		}
+		for column := 0; column < columnCount; column++ {
+			if covered[row][column] {
+				continue
+			}
+			text := ""
+			if column < len(textList) {
+				text = textList[column]
+			}
+			top := row
+			if rowIndex == 0 {
+				top = headerTop[column]
+			}
+			span := mergedSpans[[2]int{rowIndex, column}]
+			rowSpan := chart.MinInt(chart.MaxInt(span.rowSpan, 1), rowCount-row)
+			colSpan := chart.MinInt(chart.MaxInt(span.colSpan, 1), columnCount-column)
+			for i := row; i < row+rowSpan; i++ {
+				for j := column; j < column+colSpan; j++ {
+					covered[i][j] = true
+				}
+			}
+			cellRow := rowIndex
+			if rowIndex != 0 {
+				cellRow += opt.rowOffset
+			}
+			cells = append(cells, &tableLayoutCell{
+				TableCell: TableCell{
+					Text:   text,
+					Row:    cellRow,
+					Column: column,
+					Style:  currentStyle,
+				},
+				row:     top,
+				rowSpan: row - top + rowSpan,
+				colSpan: colSpan,
+			})
+		}
+	}
+	return cells, headerRowCount
+}
+
+// measureColumnWidths returns the column widths which fit the content of cells
+func (t *tableChart) measureColumnWidths(cells []*tableLayoutCell, fontSize float64, paddingWidth int) []int {
+	p := t.p
+	widths := make([]int, len(t.opt.Header))
+	cellWidths := make([]int, len(cells))
+	for index, cell := range cells {
+		p.SetStyle(cell.Style)
+		width := 0
+		content := cell.content
+		if content != nil && content.isBlock() {
+			width = tableBlockContentWidth
+		} else {
+			for _, line := range strings.Split(cell.Text, "\n") {
+				width = chart.MaxInt(width, p.MeasureText(line).Width())
+			}
+			// 文本宽度等于单元格宽度时也会换行
+			width++
+			if content != nil && content.Icon != "" {
+				width += int(fontSize) + tableIconMargin
+			}
+		}
+		cellWidths[index] = width + paddingWidth
+		if cell.colSpan == 1 && cellWidths[index] > widths[cell.Column] {
+			widths[cell.Column] = cellWidths[index]
+		}
+	}
+	// 合并的单元格宽度不足时，增加最后一列的宽度
+	for index, cell := range cells {
+		if cell.colSpan == 1 {
+			continue
+		}
+		end := cell.Column + cell.colSpan
+		diff := cellWidths[index] - sumInt(widths[cell.Column:end])
+		if diff > 0 {
+			widths[end-1] += diff
+		}
+	}
+	return widths
+}
+
+// layout calculates the size of table cells
+func (t *tableChart) layout() (*tableLayout, error) {
 	info := renderInfo{
 		RowHeights: make([]int, 0),
 	}
@@ -213,6 +634,7 @@ func (t *tableChart) render() (*renderInfo, error) {
 	if theme == nil {
 		theme = p.theme
 	}
+
 	fontSize := opt.FontSize
 	if fontSize == 0 {
 		fontSize = 12
@@ -230,21 +652,112 @@ func (t *tableChart) render() (*renderInfo, error) {
 		headerFontColor = tableDefaultSetting.HeaderFontColor
 	}
 
-	spans := opt.Spans
-	if len(spans) != len(opt.Header) {
-		newSpans := make([]int, len(opt.Header))
-		for index := range opt.Header {
-			if index >= len(spans) {
-				newSpans[index] = 1
-			} else {
-				newSpans[index] = spans[index]
+	headerStyle := Style{
+		FontSize:  fontSize,
+		FontColor: headerFontColor,
+		FillColor: headerFontColor,
+		Font:      font,
+	}
+	textStyle := headerStyle
+	textStyle.FontColor = fontColor
+	textStyle.FillColor = fontColor
+	padding := opt.Padding
+	if padding.IsZero() {
+		padding = tableDefaultSetting.Padding
+	}
+	getCellTextStyle := opt.CellTextStyle
+	if getCellTextStyle == nil {
+		getCellTextStyle = func(_ TableCell) *Style {
+			return nil
+		}
+	}
+	getCellContent := opt.CellContent
+	if getCellContent == nil {
+		getCellContent = func(_ TableCell) *TableCellContent {
+			return nil
+		}
+	}
+	paddingHeight := padding.Top + padding.Bottom
+	paddingWidth := padding.Left + padding.Right
+
+	cells, headerRowCount := t.newTableCellGrid(headerStyle, textStyle)
+	columnCount := len(opt.Header)
+	integerWidths := make([]int, columnCount)
+	decimalWidths := make([]int, columnCount)
+	for _, cell := range cells {
+		// 条件格式仅针对表格内容
+		if cell.Row > 0 {
+			for index := range opt.ConditionalFormats {
+				format := &opt.ConditionalFormats[index]
+				if !format.Match(cell.TableCell) {
+					continue
+				}
+				cell.format = format
+				if !format.FontColor.IsZero() {
+					cell.Style.FontColor = format.FontColor
+					cell.Style.FillColor = format.FontColor
+				}
+				break
+			}
+		}
+		cellStyle := getCellTextStyle(cell.TableCell)
+		if cellStyle != nil {
+			cell.Style = *cellStyle
+		}
+		if !cell.isGroup {
+			cell.content = getCellContent(cell.TableCell)
+		}
+		cell.text = cell.Text
+		cell.fontSize = fontSize
+		// 数值按小数点对齐
+		if cell.Row > 0 &&
+			cell.colSpan == 1 &&
+			getTableColumnOption(opt.TextAligns, cell.Column) == AlignDecimal &&
+			(cell.content == nil || (!cell.content.isBlock() && cell.content.Icon == "")) {
+			cell.decimal = true
+			p.SetStyle(cell.Style)
+			integerPart, decimalPart := splitDecimal(cell.Text)
+			integerWidths[cell.Column] = chart.MaxInt(integerWidths[cell.Column], p.MeasureText(integerPart).Width())
+			if decimalPart != "" {
+				decimalWidths[cell.Column] = chart.MaxInt(decimalWidths[cell.Column], p.MeasureText(decimalPart).Width())
 			}
 		}
-		spans = newSpans
 	}
 
-	sum := sumInt(spans)
-	values := autoDivideSpans(p.Width(), sum, spans)
+	var values []int
+	if len(opt.columnWidths) == columnCount {
+		// 使用指定的列宽
+		values = make([]int, columnCount+1)
+		for index, width := range opt.columnWidths {
+			values[index+1] = values[index] + width
+		}
+		if opt.FitColumnWidth {
+			info.ContentWidth = values[columnCount]
+		}
+	} else if opt.FitColumnWidth {
+		contentWidths := t.measureColumnWidths(cells, fontSize, paddingWidth)
+		for index, width := range contentWidths {
+			contentWidths[index] = chart.MaxInt(width, integerWidths[index]+decimalWidths[index]+paddingWidth+1)
+		}
+		info.ContentWidth = sumInt(contentWidths)
+		values = autoDivideWeights(p.Width(), contentWidths)
+	} else {
+		spans := opt.Spans
+		if len(spans) != len(opt.Header) {
+			newSpans := make([]int, len(opt.Header))
+			for index := range opt.Header {
+				if index >= len(spans) {
+					newSpans[index] = 1
+				} else {
+					newSpans[index] = spans[index]
+				}
+			}
+			spans = newSpans
+		}
+
+		sum := sumInt(spans)
+		values = autoDivideSpans(p.Width(), sum, spans)
+	}
 	columnWidths := make([]int, 0)
 	for index, v := range values {
 		if index == len(values)-1 {
@@ -254,91 +767,302 @@ func (t *tableChart) render() (*renderInfo, error) {
 	}
 	info.ColumnWidths = columnWidths
 
-	height := 0
-	textStyle := Style{
-		FontSize:  fontSize,
-		FontColor: headerFontColor,
-		FillColor: headerFontColor,
-		Font:      font,
+	rowHeights := make([]int, headerRowCount+len(opt.Data))
+	cellHeights := make([]int, len(cells))
+	for index, cell := range cells {
+		p.SetStyle(cell.Style)
+		width := values[cell.Column+cell.colSpan] - values[cell.Column] - paddingWidth
+		lineHeight := p.MeasureText("0").Height()
+		height := 0
+		content := cell.content
+		if content != nil && content.isBlock() {
+			height = content.Height
+			if height <= 0 {
+				height = lineHeight
+			}
+		} else {
+			if content != nil && content.Icon != "" {
+				width -= int(fontSize) + tableIconMargin
+			}
+			overflow := ""
+			if !cell.isGroup {
+				overflow = getTableColumnOption(opt.Overflows, cell.Column)
+			}
+			switch {
+			case cell.decimal:
+				height = p.MeasureText(cell.text).Height()
+			case overflow == TableOverflowEllipsis:
+				cell.text = ellipsisText(p, cell.text, width)
+				height = p.MeasureTextFit(cell.text, width).Height()
+			case overflow == TableOverflowShrink:
+				// 缩小字体至单行展示
+				for cell.Style.FontSize > 1 && p.MeasureText(cell.text).Width() >= width {
+					cell.Style.FontSize = math.Max(cell.Style.FontSize-0.5, 1)
+					cell.fontSize = cell.Style.FontSize
+					p.SetStyle(cell.Style)
+				}
+				height = p.MeasureTextFit(cell.text, width).Height()
+			default:
+				height = p.MeasureTextFit(cell.text, width).Height()
+			}
+			cell.textHeight = height
+		}
+		cellHeights[index] = height + paddingHeight
+		// 计算最高的高度
+		if cell.rowSpan == 1 && cellHeights[index] > rowHeights[cell.row] {
+			rowHeights[cell.row] = cellHeights[index]
+		}
 	}
-
-	headerHeight := 0
-	padding := opt.Padding
-	if padding.IsZero() {
-		padding = tableDefaultSetting.Padding
+	// 合并的单元格高度不足时，增加最后一行的高度
+	for index, cell := range cells {
+		if cell.rowSpan == 1 {
+			continue
+		}
+		end := cell.row + cell.rowSpan
+		diff := cellHeights[index] - sumInt(rowHeights[cell.row:end])
+		if diff > 0 {
+			rowHeights[end-1] += diff
+		}
 	}
-	getCellTextStyle := opt.CellTextStyle
-	if getCellTextStyle == nil {
-		getCellTextStyle = func(_ TableCell) *Style {
-			return nil
+	// 使用指定的行高
+	if len(opt.rowHeights) == len(opt.Data) {
+		copy(rowHeights[headerRowCount:], opt.rowHeights)
+	}
+	rowTops := make([]int, len(rowHeights)+1)
+	for index, h := range rowHeights {
+		rowTops[index+1] = rowTops[index] + h
+	}
+	for _, cell := range cells {
+		cell.box = Box{
+			Left:   values[cell.Column],
+			Top:    rowTops[cell.row],
+			Right:  values[cell.Column+cell.colSpan],
+			Bottom: rowTops[cell.row+cell.rowSpan],
 		}
 	}
-	// textAligns := opt.TextAligns
-	getTextAlign := func(index int) string {
-		if len(opt.TextAligns) <= index {
-			return ""
-		}
-		return opt.TextAligns[index]
-	}
-
-	// 表格单元的处理
-	renderTableCells := func(
-		currentStyle Style,
-		rowIndex int,
-		textList []string,
-		currentHeight int,
-		cellPadding Box,
-	) int {
-		cellMaxHeight := 0
-		paddingHeight := cellPadding.Top + cellPadding.Bottom
-		paddingWidth := cellPadding.Left + cellPadding.Right
-		for index, text := range textList {
-			cellStyle := getCellTextStyle(TableCell{
-				Text:   text,
-				Row:    rowIndex,
-				Column: index,
-				Style:  currentStyle,
-			})
-			if cellStyle == nil {
-				cellStyle = &currentStyle
+
+	info.HeaderHeight = rowTops[headerRowCount]
+	info.RowHeights = append(info.RowHeights, rowHeights[headerRowCount:]...)
+	info.Width = p.Width()
+	info.Height = rowTops[len(rowHeights)]
+	return &tableLayout{
+		cells:          cells,
+		rowHeights:     rowHeights,
+		headerRowCount: headerRowCount,
+		fontSize:       fontSize,
+		padding:        padding,
+		info:           &info,
+		decimalWidths:  decimalWidths,
+	}, nil
+}
+
+// render renders the cells of table with the layout
+func (t *tableChart) render(layout *tableLayout) error {
+	p := t.p
+	opt := t.opt
+	theme := opt.Theme
+	if theme == nil {
+		theme = p.theme
+	}
+	fontSize := layout.fontSize
+	padding := layout.padding
+
+	// 网格线
+	if !opt.GridLineColor.IsZero() {
+		p.SetDrawingStyle(Style{
+			StrokeColor: opt.GridLineColor,
+			StrokeWidth: 1,
+		})
+		info := layout.info
+		for _, cell := range layout.cells {
+			box := cell.box
+			if box.Right < info.Width {
+				p.LineStroke([]Point{
+					{
+						X: box.Right,
+						Y: box.Top,
+					},
+					{
+						X: box.Right,
+						Y: box.Bottom,
+					},
+				})
 			}
-			p.SetStyle(*cellStyle)
-			x := values[index]
-			y := currentHeight + cellPadding.Top
-			width := values[index+1] - x
-			x += cellPadding.Left
-			width -= paddingWidth
-			box := p.TextFit(text, x, y+int(fontSize), width, getTextAlign(index))
-			// 计算最高的高度
-			if box.Height()+paddingHeight > cellMaxHeight {
-				cellMaxHeight = box.Height() + paddingHeight
+			if box.Bottom < info.Height {
+				p.LineStroke([]Point{
+					{
+						X: box.Left,
+						Y: box.Bottom,
+					},
+					{
+						X: box.Right,
+						Y: box.Bottom,
+					},
+				})
 			}
 		}
-		return cellMaxHeight
 	}
 
-	// 表头的处理
-	headerHeight = renderTableCells(textStyle, 0, opt.Header, height, padding)
-	height += headerHeight
-	info.HeaderHeight = headerHeight
+	for _, cell := range layout.cells {
+		p.SetStyle(cell.Style)
+		x := cell.box.Left + padding.Left
+		y := cell.box.Top + padding.Top
+		width := cell.box.Width() - padding.Left - padding.Right
+		content := cell.content
+		// 图表等内容填充单元格
+		if content != nil && content.isBlock() {
+			err := renderTableCellContent(p, content, Box{
+				Left:   x,
+				Top:    y,
+				Right:  x + width,
+				Bottom: cell.box.Bottom - padding.Bottom,
+			}, theme)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		lineHeight := p.MeasureText("0").Height()
+		textHeight := cell.textHeight
+		if content != nil && content.Icon != "" {
+			textHeight = chart.MaxInt(textHeight, lineHeight)
+		}
+		availHeight := cell.box.Height() - padding.Top - padding.Bottom
+		switch getTableColumnOption(opt.VerticalAligns, cell.Column) {
+		case VerticalAlignMiddle:
+			y += (availHeight - textHeight) >> 1
+		case VerticalAlignBottom:
+			y += availHeight - textHeight
+		}
+		if content != nil && content.Icon != "" {
+			iconSize := int(fontSize)
+			color := content.Color
+			if color.IsZero() {
+				color = cell.Style.FontColor
+			}
+			renderTableIcon(p, content.Icon, Box{
+				Left:   x,
+				Top:    y + (lineHeight-iconSize)>>1,
+				Right:  x + iconSize,
+				Bottom: y + (lineHeight-iconSize)>>1 + iconSize,
+			}, color)
+			p.SetStyle(cell.Style)
+			x += iconSize + tableIconMargin
+			width -= iconSize + tableIconMargin
+		}
+		// 右对齐后按小数部分的宽度偏移
+		if cell.decimal {
+			_, decimalPart := splitDecimal(cell.text)
+			offset := layout.decimalWidths[cell.Column]
+			if decimalPart != "" {
+				offset -= p.MeasureText(decimalPart).Width()
+			}
+			x += width - offset - p.MeasureText(cell.text).Width()
+			p.Text(cell.text, x, y+int(cell.fontSize))
+			continue
+		}
+		align := getTableColumnOption(opt.TextAligns, cell.Column)
+		switch {
+		// 表头分组居中展示
+		case cell.isGroup:
+			align = AlignCenter
+		case align == AlignDecimal:
+			align = AlignRight
+		}
+		p.TextFit(cell.text, x, y+int(cell.fontSize), width, align)
+	}
+	return nil
+}
 
-	// 表格内容的处理
-	textStyle.FontColor = fontColor
-	textStyle.FillColor = fontColor
-	for index, textList := range opt.Data {
-		cellHeight := renderTableCells(textStyle, index+1, textList, height, padding)
-		info.RowHeights = append(info.RowHeights, cellHeight)
-		height += cellHeight
+// renderTableIcon renders the icon of table cell in the box
+func renderTableIcon(p *Painter, icon string, box Box, color Color) {
+	p.OverrideDrawingStyle(Style{
+		StrokeColor: color,
+		StrokeWidth: 1,
+		FillColor:   color,
+	})
+	cx := box.Left + box.Width()>>1
+	switch icon {
+	case TableIconRect:
+		p.Rect(box)
+	case TableIconArrowUp:
+		p.ArrowTop(cx, box.Top+box.Height()*3/4, box.Width()*3/4, box.Height()*3/4)
+	case TableIconArrowDown:
+		p.ArrowBottom(cx, box.Bottom-box.Height()/4, box.Width()*3/4, box.Height()*3/4)
+	default:
+		r := float64(box.Width()) / 2
+		cy := box.Top + box.Height()>>1
+		p.ArcTo(cx, cy, r, r, 0, math.Pi)
+		p.ArcTo(cx, cy, r, r, math.Pi, math.Pi)
+		p.Close()
+		p.FillStroke()
 	}
+}
 
-	info.Width = p.Width()
-	info.Height = height
-	return &info, nil
+// renderTableCellContent renders the chart, sparkline or progress bar in the cell box
+func renderTableCellContent(p *Painter, content *TableCellContent, box Box, theme ColorPalette) error {
+	if content.Progress != nil {
+		height := chart.MinInt(box.Height(), 8)
+		top := box.Top + (box.Height()-height)>>1
+		color := content.Color
+		if color.IsZero() {
+			color = theme.GetSeriesColor(0)
+		}
+		p.OverrideDrawingStyle(Style{
+			FillColor: theme.GetAxisSplitLineColor(),
+		}).Rect(Box{
+			Left:   box.Left,
+			Top:    top,
+			Right:  box.Right,
+			Bottom: top + height,
+		})
+		progress := math.Min(math.Max(*content.Progress, 0), 1)
+		p.OverrideDrawingStyle(Style{
+			FillColor: color,
+		}).Rect(Box{
+			Left:   box.Left,
+			Top:    top,
+			Right:  box.Left + int(float64(box.Width())*progress),
+			Bottom: top + height,
+		})
+		return nil
+	}
+	// 图表的区域为绝对位置
+	chartBox := Box{
+		Left:   p.box.Left + box.Left,
+		Top:    p.box.Top + box.Top,
+		Right:  p.box.Left + box.Right,
+		Bottom: p.box.Top + box.Bottom,
+	}
+	var opt ChartOption
+	if content.Chart != nil {
+		opt = *content.Chart
+	} else {
+		seriesList := SeriesList{
+			NewSeriesFromValues(content.Sparkline, ChartTypeLine),
+		}
+		// 无数据的sparkline不绘制
+		if !seriesList.hasValue() {
+			return nil
+		}
+		opt = ChartOption{
+			SeriesList: seriesList,
+			Sparkline: SparklineOption{
+				Enabled: true,
+			},
+		}
+	}
+	opt.Parent = p
+	opt.Box = chartBox
+	_, err := Render(opt)
+	return err
 }
 
-func (t *tableChart) renderWithInfo(info *renderInfo) (Box, error) {
+// renderWithLayout renders the backgrounds and cells of table with the layout
+func (t *tableChart) renderWithLayout(layout *tableLayout) (Box, error) {
 	p := t.p
 	opt := t.opt
+	info := layout.info
 	if !opt.BackgroundColor.IsZero() {
 		p.SetBackground(p.Width(), p.Height(), opt.BackgroundColor)
 	}
@@ -355,50 +1079,59 @@ func (t *tableChart) renderWithInfo(info *renderInfo) (Box, error) {
 		rowColors = tableDefaultSetting.RowColors
 	}
 	for index, h := range info.RowHeights {
-		color := rowColors[index%len(rowColors)]
+		color := rowColors[(index+opt.rowOffset)%len(rowColors)]
 		child := p.Child(PainterPaddingOption(Box{
 			Top: currentHeight,
 		}))
 		child.SetBackground(p.Width(), h, color, true)
 		currentHeight += h
 	}
+	// 合并的行使用第一行的背景色
+	for _, cell := range layout.cells {
+		if cell.Row == 0 || cell.rowSpan == 1 {
+			continue
+		}
+		color := rowColors[(cell.Row-1)%len(rowColors)]
+		child := p.Child(PainterPaddingOption(Box{
+			Top:  cell.box.Top,
+			Left: cell.box.Left,
+		}))
+		child.SetBackground(cell.box.Width(), cell.box.Height(), color, true)
+	}
+	// 条件格式的背景色
+	for _, cell := range layout.cells {
+		if cell.format == nil || cell.format.BackgroundColor.IsZero() {
+			continue
+		}
+		child := p.Child(PainterPaddingOption(Box{
+			Top:  cell.box.Top,
+			Left: cell.box.Left,
+		}))
+		child.SetBackground(cell.box.Width(), cell.box.Height(), cell.format.BackgroundColor, true)
+	}
 	// 根据是否有设置表格样式调整背景色
 	getCellStyle := opt.CellStyle
 	if getCellStyle != nil {
-		arr := [][]string{
-			opt.Header,
-		}
-		arr = append(arr, opt.Data...)
-		top := 0
-		heights := []int{
-			info.HeaderHeight,
-		}
-		heights = append(heights, info.RowHeights...)
 		// 循环所有表格单元，生成背景色
-		for i, textList := range arr {
-			left := 0
-			for j, v := range textList {
-				style := getCellStyle(TableCell{
-					Text:   v,
-					Row:    i,
-					Column: j,
-				})
-				if style != nil && !style.FillColor.IsZero() {
-					padding := style.Padding
-					child := p.Child(PainterPaddingOption(Box{
-						Top:  top + padding.Top,
-						Left: left + padding.Left,
-					}))
-					w := info.ColumnWidths[j] - padding.Left - padding.Top
-					h := heights[i] - padding.Top - padding.Bottom
-					child.SetBackground(w, h, style.FillColor, true)
-				}
-				left += info.ColumnWidths[j]
+		for _, cell := range layout.cells {
+			style := getCellStyle(TableCell{
+				Text:   cell.Text,
+				Row:    cell.Row,
+				Column: cell.Column,
+			})
+			if style != nil && !style.FillColor.IsZero() {
+				padding := style.Padding
+				child := p.Child(PainterPaddingOption(Box{
+					Top:  cell.box.Top + padding.Top,
+					Left: cell.box.Left + padding.Left,
+				}))
+				w := cell.box.Width() - padding.Left - padding.Top
+				h := cell.box.Height() - padding.Top - padding.Bottom
+				child.SetBackground(w, h, style.FillColor, true)
 			}
-			top += heights[i]
 		}
 	}
-	_, err := t.render()
+	err := t.render(layout)
 	if err != nil {
 		return BoxZero, err
 	}
@@ -419,20 +1152,9 @@ func (t *tableChart) Render() (Box, error) {
 		opt.Font, _ = GetFont(opt.FontFamily)
 	}
 
-	r := p.render
-	fn := chart.PNG
-	if p.outputType == ChartOutputSVG {
-		fn = chart.SVG
-	}
-	newRender, err := fn(p.Width(), 100)
-	if err != nil {
-		return BoxZero, err
-	}
-	p.render = newRender
-	info, err := t.render()
+	layout, err := t.layout()
 	if err != nil {
 		return BoxZero, err
 	}
-	p.render = r
-	return t.renderWithInfo(info)
+	return t.renderWithLayout(layout)
 }
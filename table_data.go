@@ -0,0 +1,242 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The tag of struct field for table, e.g. `table:"Name,order=1,align=right,format=%.2f,span=2"`.
+// The first value is the header name, "-" means the field is ignored.
+// The format is the layout for time.Time, otherwise it is the verb of fmt.Sprintf.
+// The value may contain comma, e.g. `table:"Date,format=Jan 2, 2006"`,
+// only the comma followed by a known key starts a new option.
+const tableTagName = "table"
+
+var tableTagKeys = []string{
+	"align",
+	"format",
+	"span",
+	"order",
+}
+
+// splitTableTag splits the tag by the comma which is followed by a known key
+func splitTableTag(tag string) []string {
+	values := make([]string, 0)
+	for index, value := range strings.Split(tag, ",") {
+		isOption := false
+		arr := strings.SplitN(value, "=", 2)
+		if len(arr) == 2 {
+			isOption = containsString(tableTagKeys, strings.TrimSpace(arr[0]))
+		}
+		// 非选项的内容属于前一个值
+		if index != 0 && !isOption {
+			values[len(values)-1] += "," + value
+			continue
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+type tableField struct {
+	index  int
+	name   string
+	align  string
+	format string
+	span   int
+	order  int
+}
+
+// NewTableOptionFromCSV returns a table option from csv, the first record is the header
+func NewTableOptionFromCSV(r io.Reader) (TableChartOption, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	// 允许每行的列数不一致
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return TableChartOption{}, err
+	}
+	if len(records) == 0 {
+		return TableChartOption{}, errors.New("The csv of table can not be empty")
+	}
+	return TableChartOption{
+		Header: records[0],
+		Data:   records[1:],
+	}, nil
+}
+
+// getTableFields returns the fields of struct type, which are sorted by order
+func getTableFields(t reflect.Type) ([]*tableField, error) {
+	fields := make([]*tableField, 0)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		// 仅导出的字段
+		if field.PkgPath != "" || field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get(tableTagName)
+		if tag == "-" {
+			continue
+		}
+		item := &tableField{
+			index: i,
+			name:  field.Name,
+			order: math.MaxInt32,
+		}
+		values := splitTableTag(tag)
+		if values[0] != "" {
+			item.name = values[0]
+		}
+		for _, value := range values[1:] {
+			arr := strings.SplitN(value, "=", 2)
+			if len(arr) != 2 {
+				continue
+			}
+			v := arr[1]
+			switch strings.TrimSpace(arr[0]) {
+			case "align":
+				item.align = v
+			case "format":
+				item.format = v
+			case "span":
+				span, err := strconv.Atoi(v)
+				if err != nil {
+					return nil, err
+				}
+				item.span = span
+			case "order":
+				order, err := strconv.Atoi(v)
+				if err != nil {
+					return nil, err
+				}
+				item.order = order
+			}
+		}
+		fields = append(fields, item)
+	}
+	// 未设置顺序的字段在后面
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].order < fields[j].order
+	})
+	return fields, nil
+}
+
+// formatTableValue returns the text of value
+func formatTableValue(value reflect.Value, format string) string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+	v := value.Interface()
+	if t, ok := v.(time.Time); ok {
+		if format == "" {
+			format = time.RFC3339
+		}
+		return t.Format(format)
+	}
+	if format != "" {
+		return fmt.Sprintf(format, v)
+	}
+	return fmt.Sprint(v)
+}
+
+// NewTableOptionFromStructs returns a table option from a slice of struct,
+// the header, alignment, format and order of column are set by the tag "table"
+func NewTableOptionFromStructs(data interface{}) (TableChartOption, error) {
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return TableChartOption{}, errors.New("The data of table should be a slice of struct")
+	}
+	t := value.Type().Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return TableChartOption{}, errors.New("The data of table should be a slice of struct")
+	}
+	fields, err := getTableFields(t)
+	if err != nil {
+		return TableChartOption{}, err
+	}
+	if len(fields) == 0 {
+		return TableChartOption{}, errors.New("The struct of table should have exported field")
+	}
+
+	opt := TableChartOption{
+		Header:     make([]string, len(fields)),
+		TextAligns: make([]string, len(fields)),
+		Spans:      make([]int, len(fields)),
+		Data:       make([][]string, 0, value.Len()),
+	}
+	hasAlign := false
+	hasSpan := false
+	for index, field := range fields {
+		opt.Header[index] = field.name
+		opt.TextAligns[index] = field.align
+		opt.Spans[index] = getDefaultInt(field.span, 1)
+		if field.align != "" {
+			hasAlign = true
+		}
+		if field.span > 0 {
+			hasSpan = true
+		}
+	}
+	if !hasAlign {
+		opt.TextAligns = nil
+	}
+	if !hasSpan {
+		opt.Spans = nil
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		item := value.Index(i)
+		for item.Kind() == reflect.Ptr || item.Kind() == reflect.Interface {
+			if item.IsNil() {
+				break
+			}
+			item = item.Elem()
+		}
+		row := make([]string, len(fields))
+		// 空指针则为空行
+		if item.Kind() == reflect.Struct {
+			for index, field := range fields {
+				row[index] = formatTableValue(item.Field(field.index), field.format)
+			}
+		}
+		opt.Data = append(opt.Data, row)
+	}
+	return opt, nil
+}
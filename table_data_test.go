@@ -0,0 +1,173 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTableOptionFromCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	opt, err := NewTableOptionFromCSV(strings.NewReader(`Name,Age,Address
+John Brown,32,"New York No. 1 Lake Park"
+Jim Green, 42
+`))
+	assert.Nil(err)
+	assert.Equal([]string{
+		"Name",
+		"Age",
+		"Address",
+	}, opt.Header)
+	assert.Equal([][]string{
+		{
+			"John Brown",
+			"32",
+			"New York No. 1 Lake Park",
+		},
+		{
+			"Jim Green",
+			"42",
+		},
+	}, opt.Data)
+
+	_, err = NewTableOptionFromCSV(strings.NewReader(""))
+	assert.NotNil(err)
+}
+
+func TestNewTableOptionFromStructs(t *testing.T) {
+	assert := assert.New(t)
+
+	type user struct {
+		Name      string    `table:"Name,order=1"`
+		Amount    float64   `table:"Amount,order=2,align=right,format=%.2f"`
+		CreatedAt time.Time `table:"Created At,format=2006-01-02"`
+		Note      *string
+		Password  string `table:"-"`
+		age       int
+	}
+	note := "vip"
+	createdAt := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	opt, err := NewTableOptionFromStructs([]*user{
+		{
+			Name:      "John Brown",
+			Amount:    12.5,
+			CreatedAt: createdAt,
+			Note:      &note,
+			Password:  "123456",
+			age:       32,
+		},
+		nil,
+		{
+			Name:      "Jim Green",
+			Amount:    1000,
+			CreatedAt: createdAt,
+		},
+	})
+	assert.Nil(err)
+	assert.Equal([]string{
+		"Name",
+		"Amount",
+		"Created At",
+		"Note",
+	}, opt.Header)
+	assert.Equal([]string{
+		"",
+		AlignRight,
+		"",
+		"",
+	}, opt.TextAligns)
+	assert.Nil(opt.Spans)
+	assert.Equal([][]string{
+		{
+			"John Brown",
+			"12.50",
+			"2022-05-01",
+			"vip",
+		},
+		{
+			"",
+			"",
+			"",
+			"",
+		},
+		{
+			"Jim Green",
+			"1000.00",
+			"2022-05-01",
+			"",
+		},
+	}, opt.Data)
+
+	_, err = NewTableOptionFromStructs([]string{
+		"a",
+	})
+	assert.NotNil(err)
+
+	_, err = NewTableOptionFromStructs(user{})
+	assert.NotNil(err)
+}
+
+func TestTableTagWithComma(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{
+		"Date",
+		"format=Jan 2, 2006",
+		"align=right",
+	}, splitTableTag("Date,format=Jan 2, 2006,align=right"))
+	assert.Equal([]string{
+		"Name, Alias",
+		"format=%s, %s",
+	}, splitTableTag("Name, Alias,format=%s, %s"))
+
+	type order struct {
+		Name      string    `table:"Name, Alias,format=[%s, ok]"`
+		CreatedAt time.Time `table:"Created At,format=Jan 2, 2006,align=right"`
+	}
+	opt, err := NewTableOptionFromStructs([]order{
+		{
+			Name:      "Coffee",
+			CreatedAt: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+	})
+	assert.Nil(err)
+	assert.Equal([]string{
+		"Name, Alias",
+		"Created At",
+	}, opt.Header)
+	assert.Equal([]string{
+		"",
+		AlignRight,
+	}, opt.TextAligns)
+	assert.Equal([][]string{
+		{
+			"[Coffee, ok]",
+			"May 1, 2022",
+		},
+	}, opt.Data)
+}
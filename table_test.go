@@ -23,6 +23,7 @@
 package charts
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -125,6 +126,223 @@ func TestTableChart(t *testing.T) {
 			},
 			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 35\nL 0 35\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(240,240,240,1.0)\"/><path  d=\"M 0 35\nL 600 35\nL 600 90\nL 0 90\nL 0 35\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 0 90\nL 600 90\nL 600 145\nL 0 145\nL 0 90\" style=\"stroke-width:0;stroke:none;fill:rgba(247,247,247,1.0)\"/><path  d=\"M 0 145\nL 600 145\nL 600 200\nL 0 200\nL 0 145\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Name</text><text x=\"130\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Age</text><text x=\"250\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Address</text><text x=\"370\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tag</text><text x=\"490\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Action</text><text x=\"10\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">John Brown</text><text x=\"130\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"250\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">New York No.</text><text x=\"250\" y=\"77\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1 Lake Park</text><text x=\"370\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">nice,</text><text x=\"370\" y=\"77\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">developer</text><text x=\"490\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Send Mail</text><text x=\"10\" y=\"112\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jim Green</text><text x=\"130\" y=\"112\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">42</text><text x=\"250\" y=\"112\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">London No. 1</text><text x=\"250\" y=\"132\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Lake Park</text><text x=\"370\" y=\"112\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">wow</text><text x=\"490\" y=\"112\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Send Mail</text><text x=\"10\" y=\"167\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Joe Black</text><text x=\"130\" y=\"167\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">32</text><text x=\"250\" y=\"167\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sidney No. 1</text><text x=\"250\" y=\"187\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Lake Park</text><text x=\"370\" y=\"167\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">cool, teacher</text><text x=\"490\" y=\"167\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Send Mail</text></svg>",
 		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewTableChart(p, TableChartOption{
+					Header: []string{
+						"Name",
+						"Trend",
+						"Progress",
+						"Change",
+					},
+					Data: [][]string{
+						{
+							"Search",
+							"",
+							"",
+							"12%",
+						},
+						{
+							"Mail",
+							"",
+							"",
+							"-3%",
+						},
+					},
+					CellContent: func(tc TableCell) *TableCellContent {
+						if tc.Row == 0 {
+							return nil
+						}
+						switch tc.Column {
+						case 1:
+							return &TableCellContent{
+								Sparkline: []float64{
+									1,
+									3,
+									2,
+									float64(4 + tc.Row),
+								},
+								Height: 30,
+							}
+						case 2:
+							return &TableCellContent{
+								Progress: NewFloatPoint(0.3 * float64(tc.Row)),
+							}
+						case 3:
+							if tc.Row == 1 {
+								return &TableCellContent{
+									Icon:  TableIconArrowUp,
+									Color: Color{R: 145, G: 204, B: 117, A: 255},
+								}
+							}
+							return &TableCellContent{
+								Icon:  TableIconArrowDown,
+								Color: Color{R: 238, G: 102, B: 102, A: 255},
+							}
+						}
+						return nil
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 35\nL 0 35\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(240,240,240,1.0)\"/><path  d=\"M 0 35\nL 600 35\nL 600 85\nL 0 85\nL 0 35\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 0 85\nL 600 85\nL 600 135\nL 0 135\nL 0 85\" style=\"stroke-width:0;stroke:none;fill:rgba(247,247,247,1.0)\"/><text x=\"10\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Name</text><text x=\"160\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Trend</text><text x=\"310\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Progress</text><text x=\"460\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Change</text><text x=\"10\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search</text><path  d=\"M 160 75\nL 203 60\nL 246 68\nL 290 45\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 310 56\nL 440 56\nL 440 64\nL 310 64\nL 310 56\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 310 56\nL 349 56\nL 349 64\nL 310 64\nL 310 56\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 462 55\nL 466 46\nL 471 55\nL 466 52\nL 462 55\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"477\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">12%</text><text x=\"10\" y=\"107\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mail</text><path  d=\"M 160 125\nL 203 113\nL 246 119\nL 290 95\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 310 106\nL 440 106\nL 440 114\nL 310 114\nL 310 106\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 310 106\nL 388 106\nL 388 114\nL 310 114\nL 310 106\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 462 96\nL 466 105\nL 471 96\nL 466 99\nL 462 96\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"477\" y=\"107\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-3%</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewTableChart(p, TableChartOption{
+					Header: []string{
+						"Region",
+						"Jan",
+						"Feb",
+						"Apr",
+						"May",
+					},
+					HeaderGroups: [][]TableHeaderGroup{
+						{
+							{
+								Text: "",
+							},
+							{
+								Text: "Q1",
+								Span: 2,
+							},
+							{
+								Text: "Q2",
+								Span: 2,
+							},
+						},
+					},
+					Data: [][]string{
+						{
+							"North",
+							"10",
+							"12",
+							"11",
+							"13",
+						},
+						{
+							"",
+							"8",
+							"7",
+							"9",
+							"8",
+						},
+						{
+							"South",
+							"N/A",
+							"",
+							"5",
+							"6",
+						},
+					},
+					MergedCells: []TableMergedCell{
+						{
+							Row:     1,
+							Column:  0,
+							RowSpan: 2,
+						},
+						{
+							Row:     3,
+							Column:  1,
+							ColSpan: 2,
+						},
+					},
+					GridLineColor: Color{
+						R: 220,
+						G: 220,
+						B: 220,
+						A: 255,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 70\nL 0 70\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(240,240,240,1.0)\"/><path  d=\"M 0 70\nL 600 70\nL 600 105\nL 0 105\nL 0 70\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 0 105\nL 600 105\nL 600 140\nL 0 140\nL 0 105\" style=\"stroke-width:0;stroke:none;fill:rgba(247,247,247,1.0)\"/><path  d=\"M 0 140\nL 600 140\nL 600 175\nL 0 175\nL 0 140\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 0 70\nL 120 70\nL 120 140\nL 0 140\nL 0 70\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 360 0\nL 360 35\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 120 35\nL 360 35\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 360 35\nL 600 35\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 120 0\nL 120 70\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 0 70\nL 120 70\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 240 35\nL 240 70\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 120 70\nL 240 70\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 360 35\nL 360 70\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 240 70\nL 360 70\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 480 35\nL 480 70\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 360 70\nL 480 70\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 480 70\nL 600 70\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 120 70\nL 120 140\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 0 140\nL 120 140\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 240 70\nL 240 105\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 120 105\nL 240 105\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 360 70\nL 360 105\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 240 105\nL 360 105\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 480 70\nL 480 105\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 360 105\nL 480 105\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 480 105\nL 600 105\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 240 105\nL 240 140\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 120 140\nL 240 140\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 360 105\nL 360 140\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 240 140\nL 360 140\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 480 105\nL 480 140\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 360 140\nL 480 140\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 480 140\nL 600 140\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 120 140\nL 120 175\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 360 140\nL 360 175\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><path  d=\"M 480 140\nL 480 175\" style=\"stroke-width:1;stroke:rgba(220,220,220,1.0);fill:none\"/><text x=\"230\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Q1</text><text x=\"470\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Q2</text><text x=\"10\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Region</text><text x=\"130\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Jan</text><text x=\"250\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"370\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Apr</text><text x=\"490\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"10\" y=\"92\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">North</text><text x=\"130\" y=\"92\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><text x=\"250\" y=\"92\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">12</text><text x=\"370\" y=\"92\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">11</text><text x=\"490\" y=\"92\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">13</text><text x=\"130\" y=\"127\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"250\" y=\"127\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">7</text><text x=\"370\" y=\"127\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">9</text><text x=\"490\" y=\"127\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"10\" y=\"162\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">South</text><text x=\"130\" y=\"162\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">N/A</text><text x=\"370\" y=\"162\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"490\" y=\"162\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewTableChart(p, TableChartOption{
+					Header: []string{
+						"Name",
+						"Description",
+						"Summary",
+						"Amount",
+						"Change",
+					},
+					TextAligns: []string{
+						"",
+						"",
+						"",
+						AlignDecimal,
+						AlignDecimal,
+					},
+					VerticalAligns: []string{
+						"",
+						"",
+						"",
+						VerticalAlignBottom,
+						VerticalAlignMiddle,
+					},
+					Overflows: []string{
+						"",
+						TableOverflowEllipsis,
+						TableOverflowShrink,
+					},
+					Data: [][]string{
+						{
+							"Search",
+							"A very long description",
+							"Long summary text",
+							"1,234.5",
+							"12.25%",
+						},
+						{
+							"Mail service with two lines",
+							"Short",
+							"Short",
+							"3.125",
+							"-3%",
+						},
+					},
+					ConditionalFormats: []TableConditionalFormat{
+						{
+							Columns: []int{
+								4,
+							},
+							Max: NewFloatPoint(0),
+							FontColor: Color{
+								R: 238,
+								G: 102,
+								B: 102,
+								A: 255,
+							},
+						},
+						{
+							Columns: []int{
+								4,
+							},
+							Min: NewFloatPoint(10),
+							BackgroundColor: Color{
+								R: 220,
+								G: 240,
+								B: 210,
+								A: 255,
+							},
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 35\nL 0 35\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(240,240,240,1.0)\"/><path  d=\"M 0 35\nL 600 35\nL 600 70\nL 0 70\nL 0 35\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 0 70\nL 600 70\nL 600 125\nL 0 125\nL 0 70\" style=\"stroke-width:0;stroke:none;fill:rgba(247,247,247,1.0)\"/><path  d=\"M 480 35\nL 600 35\nL 600 70\nL 480 70\nL 480 35\" style=\"stroke-width:0;stroke:none;fill:rgba(220,240,210,1.0)\"/><text x=\"10\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Name</text><text x=\"130\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Description</text><text x=\"250\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Summary</text><text x=\"415\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Amount</text><text x=\"537\" y=\"22\" style=\"stroke-width:0;stroke:none;fill:rgba(98,105,118,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Change</text><text x=\"10\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search</text><text x=\"130\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A very long...</text><text x=\"250\" y=\"53\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:10.9px;font-family:'Roboto Medium',sans-serif\">Long summary text</text><text x=\"400\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1,234.5</text><text x=\"539\" y=\"57\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">12.25%</text><text x=\"10\" y=\"92\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mail service</text><text x=\"10\" y=\"112\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">with two lines</text><text x=\"130\" y=\"92\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Short</text><text x=\"250\" y=\"92\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Short</text><text x=\"430\" y=\"112\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3.125</text><text x=\"532\" y=\"102\" style=\"stroke-width:0;stroke:none;fill:rgba(238,102,102,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">-3%</text></svg>",
+		},
 	}
 	for _, tt := range tests {
 		p, err := NewPainter(PainterOptions{
@@ -138,3 +356,247 @@ func TestTableChart(t *testing.T) {
 		assert.Equal(tt.result, string(data))
 	}
 }
+
+func TestTableEmptySparkline(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  600,
+		Height: 400,
+	}, PainterThemeOption(defaultTheme))
+	assert.Nil(err)
+	_, err = NewTableChart(p, TableChartOption{
+		Header: []string{
+			"Name",
+			"Trend",
+		},
+		Data: [][]string{
+			{
+				"Search",
+				"",
+			},
+			{
+				"Mail",
+				"",
+			},
+		},
+		CellContent: func(tc TableCell) *TableCellContent {
+			if tc.Row == 0 || tc.Column != 1 {
+				return nil
+			}
+			// 未有数据或全为空值
+			if tc.Row == 1 {
+				return &TableCellContent{
+					Sparkline: []float64{},
+				}
+			}
+			return &TableCellContent{
+				Sparkline: []float64{
+					GetNullValue(),
+					GetNullValue(),
+				},
+			}
+		},
+	}).Render()
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Contains(string(data), ">Mail</text>")
+	assert.NotContains(string(data), "stroke:rgba(84,112,198,1.0)")
+}
+
+func TestTableLayoutOnce(t *testing.T) {
+	assert := assert.New(t)
+
+	count := 0
+	opt := TableChartOption{
+		Type: ChartOutputSVG,
+		Header: []string{
+			"Name",
+			"Trend",
+		},
+		Data: [][]string{
+			{
+				"Search",
+				"",
+			},
+		},
+		CellContent: func(tc TableCell) *TableCellContent {
+			count++
+			if tc.Column != 1 || tc.Row == 0 {
+				return nil
+			}
+			return &TableCellContent{
+				Sparkline: []float64{
+					1,
+					2,
+				},
+			}
+		},
+	}
+	_, err := TableOptionRender(opt)
+	assert.Nil(err)
+	// 测量与渲染共用同一布局
+	assert.Equal(4, count)
+
+	count = 0
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  600,
+		Height: 400,
+	})
+	assert.Nil(err)
+	_, err = NewTableChart(p, opt).Render()
+	assert.Nil(err)
+	assert.Equal(4, count)
+}
+
+func TestTableConditionalFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	format := TableConditionalFormat{
+		Columns: []int{
+			1,
+		},
+		Min: NewFloatPoint(10),
+		Max: NewFloatPoint(100),
+	}
+	assert.True(format.Match(TableCell{
+		Text:   "1,0.5",
+		Column: 1,
+	}))
+	assert.True(format.Match(TableCell{
+		Text:   "12%",
+		Column: 1,
+	}))
+	assert.False(format.Match(TableCell{
+		Text:   "100",
+		Column: 1,
+	}))
+	assert.False(format.Match(TableCell{
+		Text:   "12",
+		Column: 0,
+	}))
+	assert.False(format.Match(TableCell{
+		Text:   "N/A",
+		Column: 1,
+	}))
+}
+
+func TestTableConditionalFormatJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	format := TableConditionalFormat{
+		Columns: []int{
+			1,
+			2,
+		},
+		Min: NewFloatPoint(10),
+		FontColor: Color{
+			R: 238,
+			G: 102,
+			B: 102,
+			A: 255,
+		},
+	}
+	buf, err := json.Marshal(format)
+	assert.Nil(err)
+	assert.Equal(`{"columns":[1,2],"min":10,"max":null,"fontColor":"#ee6666"}`, string(buf))
+
+	result := TableConditionalFormat{}
+	err = json.Unmarshal(buf, &result)
+	assert.Nil(err)
+	assert.Equal(format, result)
+
+	result = TableConditionalFormat{}
+	err = json.Unmarshal([]byte(`{"columns":[3],"max":0,"fontColor":"rgba(238,102,102,128)","backgroundColor":"#dcf0d2"}`), &result)
+	assert.Nil(err)
+	assert.Equal(TableConditionalFormat{
+		Columns: []int{
+			3,
+		},
+		Max: NewFloatPoint(0),
+		FontColor: Color{
+			R: 238,
+			G: 102,
+			B: 102,
+			A: 128,
+		},
+		BackgroundColor: Color{
+			R: 220,
+			G: 240,
+			B: 210,
+			A: 255,
+		},
+	}, result)
+	buf, err = json.Marshal(result)
+	assert.Nil(err)
+	assert.Equal(`{"columns":[3],"min":null,"max":0,"fontColor":"rgba(238,102,102,128)","backgroundColor":"#dcf0d2"}`, string(buf))
+
+	// rgba对象
+	result = TableConditionalFormat{}
+	err = json.Unmarshal([]byte(`{"backgroundColor":{"R":220,"G":240,"B":210,"A":255}}`), &result)
+	assert.Nil(err)
+	assert.Equal(TableConditionalFormat{
+		BackgroundColor: Color{
+			R: 220,
+			G: 240,
+			B: 210,
+			A: 255,
+		},
+	}, result)
+
+	// 表格配置
+	opt := TableChartOption{}
+	err = json.Unmarshal([]byte(`{"verticalAligns":["middle"],"overflows":["ellipsis"],"conditionalFormats":[{"min":100,"fontColor":"#ee6666"}]}`), &opt)
+	assert.Nil(err)
+	assert.Equal([]string{
+		VerticalAlignMiddle,
+	}, opt.VerticalAligns)
+	assert.Equal([]string{
+		TableOverflowEllipsis,
+	}, opt.Overflows)
+	assert.Equal([]TableConditionalFormat{
+		{
+			Min: NewFloatPoint(100),
+			FontColor: Color{
+				R: 238,
+				G: 102,
+				B: 102,
+				A: 255,
+			},
+		},
+	}, opt.ConditionalFormats)
+}
+
+func TestSplitDecimal(t *testing.T) {
+	assert := assert.New(t)
+
+	integerPart, decimalPart := splitDecimal("1,234.56%")
+	assert.Equal("1,234", integerPart)
+	assert.Equal(".56%", decimalPart)
+
+	integerPart, decimalPart = splitDecimal("12")
+	assert.Equal("12", integerPart)
+	assert.Equal("", decimalPart)
+}
+
+func TestEllipsisText(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  400,
+		Height: 300,
+	})
+	assert.Nil(err)
+	f, _ := GetDefaultFont()
+	p.SetStyle(Style{
+		FontSize: 12,
+		Font:     f,
+	})
+	assert.Equal("Hello World!", ellipsisText(p, "Hello World!", 200))
+	assert.Equal("Hello...", ellipsisText(p, "Hello World!", 60))
+	assert.Equal("...", ellipsisText(p, "Hello World!", 5))
+}
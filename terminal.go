@@ -0,0 +1,661 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"unicode"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+const (
+	// 每个字符对应的像素宽高
+	terminalCellWidth  = 8
+	terminalCellHeight = 16
+	// 每个盲文点对应的像素
+	terminalDotSize = 4
+)
+
+const (
+	TerminalColorTrue = "truecolor"
+	TerminalColor256  = "256"
+	TerminalColorNone = "none"
+)
+
+type TerminalOption struct {
+	// The columns of terminal, each column is 8 pixels of chart width
+	Columns int
+	// The rows of terminal, each row is 16 pixels of chart height
+	Rows int
+	// The color mode: "truecolor", "256" or "none", default is "truecolor"
+	Color string
+	// Output the background color of theme, the background of terminal is used by default
+	Background bool
+}
+
+// terminalCell is a character of terminal,
+// the stroke and fill are the bits of 2x4 braille dots
+type terminalCell struct {
+	stroke      uint8
+	strokeColor Color
+	fill        uint8
+	fillColor   Color
+	text        rune
+	textColor   Color
+	// 宽字符占用的第二个位置
+	skip bool
+	// 描边与填充的绘制顺序，后绘制的优先展示
+	strokeSeq int
+	fillSeq   int
+}
+
+type terminalPoint struct {
+	X float64
+	Y float64
+}
+
+// terminalRenderer renders the chart as unicode braille and block characters
+type terminalRenderer struct {
+	width      int
+	height     int
+	columns    int
+	rows       int
+	dpi        float64
+	s          chart.Style
+	cells      []terminalCell
+	seq        int
+	paths      [][]terminalPoint
+	background Color
+	colorMode  string
+	// 是否输出背景色
+	showBackground bool
+	fillGradient   *Gradient
+}
+
+// braille的点位，按[x][y]排列
+var terminalBrailleBits = [2][4]uint8{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+const (
+	terminalTopHalf    = 0x1b
+	terminalBottomHalf = 0xe4
+	terminalLeftHalf   = 0x47
+	terminalRightHalf  = 0xb8
+)
+
+// newTerminalRenderer returns a new terminal renderer
+func newTerminalRenderer(width, height int) (chart.Renderer, error) {
+	columns := (width + terminalCellWidth - 1) / terminalCellWidth
+	rows := (height + terminalCellHeight - 1) / terminalCellHeight
+	return &terminalRenderer{
+		width:      width,
+		height:     height,
+		columns:    columns,
+		rows:       rows,
+		dpi:        chart.DefaultDPI,
+		cells:      make([]terminalCell, columns*rows),
+		background: drawing.ColorWhite,
+		colorMode:  TerminalColorTrue,
+	}, nil
+}
+
+// isTerminal checks whether the output of painter is terminal
+func (p *Painter) isTerminal() bool {
+	return p.outputType == ChartOutputTerminal
+}
+
+// getTerminalDivideCount returns the divide count of value axis for terminal,
+// each label of axis takes a row, so the count is reduced if the rows are not enough
+func (p *Painter) getTerminalDivideCount(size, divideCount int) int {
+	if !p.isTerminal() {
+		return divideCount
+	}
+	maxCount := size / terminalCellHeight
+	if divideCount > maxCount {
+		return chart.MaxInt(maxCount, 1)
+	}
+	return divideCount
+}
+
+// getTerminalHeaderPadding returns the top padding of chart area for terminal,
+// a blank row is kept between the rows of title(legend) and chart area
+func (p *Painter) getTerminalHeaderPadding(headerHeight int) int {
+	bottom := p.box.Top + headerHeight
+	rows := (bottom+terminalCellHeight-1)/terminalCellHeight + 1
+	return rows*terminalCellHeight - p.box.Top
+}
+
+// setTerminalOption sets the color option of terminal output
+func (p *Painter) setTerminalOption(opt TerminalOption) {
+	r, ok := p.render.(*terminalRenderer)
+	if !ok {
+		return
+	}
+	if opt.Color != "" {
+		r.colorMode = opt.Color
+	}
+	r.showBackground = opt.Background
+}
+
+func (r *terminalRenderer) ResetStyle() {
+	r.s = chart.Style{
+		Font: r.s.Font,
+	}
+	r.fillGradient = nil
+}
+
+func (r *terminalRenderer) setFillGradient(g *Gradient) {
+	r.fillGradient = g
+}
+
+func (r *terminalRenderer) GetDPI() float64 {
+	return r.dpi
+}
+
+func (r *terminalRenderer) SetDPI(dpi float64) {
+	r.dpi = dpi
+}
+
+func (r *terminalRenderer) SetClassName(className string) {
+	r.s.ClassName = className
+}
+
+func (r *terminalRenderer) SetStrokeColor(c drawing.Color) {
+	r.s.StrokeColor = c
+}
+
+func (r *terminalRenderer) SetFillColor(c drawing.Color) {
+	r.s.FillColor = c
+}
+
+func (r *terminalRenderer) SetStrokeWidth(width float64) {
+	r.s.StrokeWidth = width
+}
+
+func (r *terminalRenderer) SetStrokeDashArray(dashArray []float64) {
+	r.s.StrokeDashArray = dashArray
+}
+
+func (r *terminalRenderer) lastPoint() (terminalPoint, bool) {
+	if len(r.paths) == 0 {
+		return terminalPoint{}, false
+	}
+	path := r.paths[len(r.paths)-1]
+	if len(path) == 0 {
+		return terminalPoint{}, false
+	}
+	return path[len(path)-1], true
+}
+
+func (r *terminalRenderer) moveTo(x, y float64) {
+	r.paths = append(r.paths, []terminalPoint{
+		{
+			X: x,
+			Y: y,
+		},
+	})
+}
+
+func (r *terminalRenderer) lineTo(x, y float64) {
+	if len(r.paths) == 0 {
+		r.moveTo(x, y)
+		return
+	}
+	index := len(r.paths) - 1
+	r.paths[index] = append(r.paths[index], terminalPoint{
+		X: x,
+		Y: y,
+	})
+}
+
+func (r *terminalRenderer) MoveTo(x, y int) {
+	r.moveTo(float64(x), float64(y))
+}
+
+func (r *terminalRenderer) LineTo(x, y int) {
+	r.lineTo(float64(x), float64(y))
+}
+
+// QuadCurveTo flattens the quad curve to lines
+func (r *terminalRenderer) QuadCurveTo(cx, cy, x, y int) {
+	start, ok := r.lastPoint()
+	if !ok {
+		r.MoveTo(x, y)
+		return
+	}
+	count := 8
+	for i := 1; i <= count; i++ {
+		t := float64(i) / float64(count)
+		mt := 1 - t
+		r.lineTo(
+			mt*mt*start.X+2*mt*t*float64(cx)+t*t*float64(x),
+			mt*mt*start.Y+2*mt*t*float64(cy)+t*t*float64(y),
+		)
+	}
+}
+
+// ArcTo flattens the arc to lines, the angle is the same as raster renderer
+func (r *terminalRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	// 每一段不超过5度
+	count := int(math.Ceil(math.Abs(delta) / (math.Pi / 36)))
+	for i := 0; i <= count; i++ {
+		angle := startAngle + delta*float64(i)/float64(chart.MaxInt(count, 1))
+		x := float64(cx) + math.Cos(angle)*rx
+		y := float64(cy) + math.Sin(angle)*ry
+		if i == 0 && len(r.paths) == 0 {
+			r.moveTo(x, y)
+			continue
+		}
+		r.lineTo(x, y)
+	}
+}
+
+func (r *terminalRenderer) Close() {
+	if len(r.paths) == 0 {
+		return
+	}
+	path := r.paths[len(r.paths)-1]
+	r.lineTo(path[0].X, path[0].Y)
+}
+
+// getColor returns the opaque color, which is blended with background
+func (r *terminalRenderer) getColor(c Color) Color {
+	if c.A == 255 {
+		return c
+	}
+	return blendColor(r.background, c, float64(c.A)/255)
+}
+
+func (r *terminalRenderer) getCell(dotX, dotY int) (*terminalCell, uint8) {
+	if dotX < 0 || dotY < 0 || dotX >= 2*r.columns || dotY >= 4*r.rows {
+		return nil, 0
+	}
+	cell := &r.cells[(dotY/4)*r.columns+dotX/2]
+	return cell, terminalBrailleBits[dotX%2][dotY%4]
+}
+
+func (r *terminalRenderer) setStrokeDot(dotX, dotY int, c Color) {
+	cell, bit := r.getCell(dotX, dotY)
+	if cell == nil {
+		return
+	}
+	cell.stroke |= bit
+	cell.strokeColor = c
+	cell.strokeSeq = r.seq
+}
+
+func (r *terminalRenderer) setFillDot(dotX, dotY int, c Color) {
+	cell, bit := r.getCell(dotX, dotY)
+	if cell == nil {
+		return
+	}
+	// 填充覆盖之前的描边
+	cell.stroke &^= bit
+	cell.fill |= bit
+	cell.fillColor = c
+	cell.fillSeq = r.seq
+}
+
+func (r *terminalRenderer) strokePath(c Color) {
+	for _, path := range r.paths {
+		for i := 0; i < len(path); i++ {
+			start := path[i]
+			end := start
+			if i+1 < len(path) {
+				end = path[i+1]
+			} else if len(path) != 1 {
+				break
+			}
+			x0 := start.X / terminalDotSize
+			y0 := start.Y / terminalDotSize
+			x1 := end.X / terminalDotSize
+			y1 := end.Y / terminalDotSize
+			count := int(math.Ceil(math.Max(math.Abs(x1-x0), math.Abs(y1-y0))))
+			for j := 0; j <= count; j++ {
+				t := float64(0)
+				if count != 0 {
+					t = float64(j) / float64(count)
+				}
+				r.setStrokeDot(
+					int(math.Floor(x0+(x1-x0)*t)),
+					int(math.Floor(y0+(y1-y0)*t)),
+					c,
+				)
+			}
+		}
+	}
+}
+
+// fillPath fills the dots whose center is inside the path(even-odd rule),
+// the color of dot is got from the fill gradient if it is set,
+// it returns false if no dot is filled
+func (r *terminalRenderer) fillPath(c Color) bool {
+	filled := false
+	var box pathBounds
+	if r.fillGradient != nil {
+		for _, path := range r.paths {
+			for _, p := range path {
+				box.add(p.X, p.Y)
+			}
+		}
+	}
+	for dotY := 0; dotY < 4*r.rows; dotY++ {
+		y := (float64(dotY) + 0.5) * terminalDotSize
+		xList := make([]float64, 0)
+		for _, path := range r.paths {
+			count := len(path)
+			for i := 0; i < count; i++ {
+				p0 := path[i]
+				p1 := path[(i+1)%count]
+				if (p0.Y <= y && p1.Y > y) || (p1.Y <= y && p0.Y > y) {
+					xList = append(xList, p0.X+(y-p0.Y)*(p1.X-p0.X)/(p1.Y-p0.Y))
+				}
+			}
+		}
+		sort.Float64s(xList)
+		for i := 0; i+1 < len(xList); i += 2 {
+			start := int(math.Ceil(xList[i]/terminalDotSize - 0.5))
+			end := int(math.Floor(xList[i+1]/terminalDotSize - 0.5))
+			for dotX := start; dotX <= end; dotX++ {
+				dotColor := c
+				if r.fillGradient != nil {
+					dotColor = r.getColor(r.fillGradient.getColorAt((float64(dotX)+0.5)*terminalDotSize, y, box))
+				}
+				r.setFillDot(dotX, dotY, dotColor)
+				filled = true
+			}
+		}
+	}
+	return filled
+}
+
+// isBackground checks whether the path covers the whole canvas
+func (r *terminalRenderer) isBackground() bool {
+	if len(r.paths) != 1 {
+		return false
+	}
+	minX, minY := math.MaxFloat64, math.MaxFloat64
+	maxX, maxY := -math.MaxFloat64, -math.MaxFloat64
+	for _, p := range r.paths[0] {
+		minX = math.Min(minX, p.X)
+		minY = math.Min(minY, p.Y)
+		maxX = math.Max(maxX, p.X)
+		maxY = math.Max(maxY, p.Y)
+	}
+	return minX <= 0 && minY <= 0 && maxX >= float64(r.width) && maxY >= float64(r.height)
+}
+
+func (r *terminalRenderer) drawPath(fill, stroke bool) {
+	if len(r.paths) == 0 {
+		return
+	}
+	s := r.s
+	fill = fill && !s.FillColor.IsZero()
+	stroke = stroke && !s.StrokeColor.IsZero() && s.StrokeWidth > 0
+	// 覆盖整个画布的填充作为背景色
+	if fill && r.fillGradient == nil && r.isBackground() {
+		r.background = s.FillColor.WithAlpha(255)
+		r.cells = make([]terminalCell, r.columns*r.rows)
+		r.paths = nil
+		return
+	}
+	r.seq++
+	if fill {
+		// 太小无法填充时以描边展示
+		if !r.fillPath(r.getColor(s.FillColor)) && !stroke {
+			r.strokePath(r.getColor(s.FillColor))
+		}
+	}
+	if stroke {
+		r.strokePath(r.getColor(s.StrokeColor))
+	}
+	r.paths = nil
+}
+
+func (r *terminalRenderer) Stroke() {
+	r.drawPath(false, true)
+}
+
+func (r *terminalRenderer) Fill() {
+	r.drawPath(true, false)
+}
+
+func (r *terminalRenderer) FillStroke() {
+	r.drawPath(true, true)
+}
+
+// Circle adds a circle to the path but does not apply the fill or stroke
+func (r *terminalRenderer) Circle(radius float64, x, y int) {
+	r.moveTo(float64(x)+radius, float64(y))
+	r.ArcTo(x, y, radius, radius, 0, 2*math.Pi)
+}
+
+func (r *terminalRenderer) SetFont(f *truetype.Font) {
+	r.s.Font = f
+}
+
+func (r *terminalRenderer) SetFontColor(c drawing.Color) {
+	r.s.FontColor = c
+}
+
+func (r *terminalRenderer) SetFontSize(size float64) {
+	r.s.FontSize = size
+}
+
+// getRuneWidth returns the columns of rune, the east asian character is two columns
+func getRuneWidth(value rune) int {
+	if unicode.In(value, unicode.Han, unicode.Hangul, unicode.Hiragana, unicode.Katakana) ||
+		(value >= 0xff01 && value <= 0xff60) {
+		return 2
+	}
+	return 1
+}
+
+// Text writes the text to the cells, the y is the baseline of text
+func (r *terminalRenderer) Text(body string, x, y int) {
+	row := int(math.Floor(float64(y-terminalCellHeight/2) / terminalCellHeight))
+	column := int(math.Floor(float64(x+terminalCellWidth/2) / terminalCellWidth))
+	if row < 0 || row >= r.rows {
+		return
+	}
+	c := r.getColor(r.s.FontColor)
+	for _, value := range body {
+		width := getRuneWidth(value)
+		if column >= 0 && column+width <= r.columns {
+			cell := &r.cells[row*r.columns+column]
+			*cell = terminalCell{
+				text:      value,
+				textColor: c,
+			}
+			if width == 2 {
+				r.cells[row*r.columns+column+1] = terminalCell{
+					skip: true,
+				}
+			}
+		}
+		column += width
+	}
+}
+
+// MeasureText returns the box of text, each column is a cell
+func (r *terminalRenderer) MeasureText(body string) chart.Box {
+	width := 0
+	for _, value := range body {
+		width += getRuneWidth(value)
+	}
+	return chart.Box{
+		Right:  width * terminalCellWidth,
+		Bottom: terminalCellHeight,
+	}
+}
+
+// SetTextRotation is ignored, the text of terminal is horizontal
+func (r *terminalRenderer) SetTextRotation(radians float64) {
+}
+
+func (r *terminalRenderer) ClearTextRotation() {
+}
+
+// ansiColor returns the ansi escape of color
+func (r *terminalRenderer) ansiColor(c Color, background bool) string {
+	code := "38"
+	if background {
+		code = "48"
+	}
+	if r.colorMode == TerminalColor256 {
+		return "\x1b[" + code + ";5;" + strconv.Itoa(int(getANSI256Color(c))) + "m"
+	}
+	return "\x1b[" + code + ";2;" + strconv.Itoa(int(c.R)) + ";" + strconv.Itoa(int(c.G)) + ";" + strconv.Itoa(int(c.B)) + "m"
+}
+
+// getANSI256Color returns the nearest color of xterm 256 colors(cube and gray)
+func getANSI256Color(c Color) uint8 {
+	levels := []int{0, 95, 135, 175, 215, 255}
+	nearest := func(v uint8) int {
+		index := 0
+		for i, level := range levels {
+			if math.Abs(float64(int(v)-level)) < math.Abs(float64(int(v)-levels[index])) {
+				index = i
+			}
+		}
+		return index
+	}
+	distance := func(r, g, b int) int {
+		dr := int(c.R) - r
+		dg := int(c.G) - g
+		db := int(c.B) - b
+		return dr*dr + dg*dg + db*db
+	}
+	ri := nearest(c.R)
+	gi := nearest(c.G)
+	bi := nearest(c.B)
+	cubeIndex := 16 + 36*ri + 6*gi + bi
+	cubeDistance := distance(levels[ri], levels[gi], levels[bi])
+
+	// 灰度 8, 18, ..., 238
+	avg := (int(c.R) + int(c.G) + int(c.B)) / 3
+	grayIndex := (avg - 3) / 10
+	if grayIndex < 0 {
+		grayIndex = 0
+	}
+	if grayIndex > 23 {
+		grayIndex = 23
+	}
+	gray := 8 + grayIndex*10
+	if distance(gray, gray, gray) < cubeDistance {
+		return uint8(232 + grayIndex)
+	}
+	return uint8(cubeIndex)
+}
+
+func countBits(value uint8) int {
+	count := 0
+	for ; value != 0; value &= value - 1 {
+		count++
+	}
+	return count
+}
+
+// getCellContent returns the character, foreground and background of cell
+func (r *terminalRenderer) getCellContent(cell terminalCell) (rune, Color, Color) {
+	var bg Color
+	if r.showBackground {
+		bg = r.background
+	}
+	switch {
+	case cell.text != 0:
+		if cell.fill == 0xff {
+			bg = cell.fillColor
+		}
+		return cell.text, cell.textColor, bg
+	case cell.stroke != 0 && (cell.fill == 0 || cell.strokeSeq > cell.fillSeq):
+		if cell.fill == 0xff {
+			bg = cell.fillColor
+		}
+		return rune(0x2800 + int(cell.stroke)), cell.strokeColor, bg
+	case cell.fill != 0:
+		switch {
+		// 大部分填充时以整块展示
+		case countBits(cell.fill) >= 6:
+			return '█', cell.fillColor, bg
+		case cell.fill == terminalTopHalf:
+			return '▀', cell.fillColor, bg
+		case cell.fill == terminalBottomHalf:
+			return '▄', cell.fillColor, bg
+		case cell.fill == terminalLeftHalf:
+			return '▌', cell.fillColor, bg
+		case cell.fill == terminalRightHalf:
+			return '▐', cell.fillColor, bg
+		}
+		return rune(0x2800 + int(cell.fill)), cell.fillColor, bg
+	}
+	return ' ', Color{}, bg
+}
+
+// Save writes the rows of characters with ansi colors
+func (r *terminalRenderer) Save(w io.Writer) error {
+	buf := bytes.Buffer{}
+	colorful := r.colorMode != TerminalColorNone
+	for row := 0; row < r.rows; row++ {
+		var fg, bg Color
+		for column := 0; column < r.columns; column++ {
+			cell := r.cells[row*r.columns+column]
+			if cell.skip {
+				continue
+			}
+			value, cellFg, cellBg := r.getCellContent(cell)
+			if colorful {
+				if cellBg != bg {
+					if cellBg.IsZero() {
+						// 重置后重新设置前景色
+						buf.WriteString("\x1b[0m")
+						fg = Color{}
+					} else {
+						buf.WriteString(r.ansiColor(cellBg, true))
+					}
+					bg = cellBg
+				}
+				if value != ' ' && !cellFg.IsZero() && cellFg != fg {
+					buf.WriteString(r.ansiColor(cellFg, false))
+					fg = cellFg
+				}
+			}
+			buf.WriteRune(value)
+		}
+		if colorful && (!fg.IsZero() || !bg.IsZero()) {
+			buf.WriteString("\x1b[0m")
+		}
+		buf.WriteByte('\n')
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,270 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func TestTerminalRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputTerminal,
+		Width:  48,
+		Height: 32,
+	})
+	assert.Nil(err)
+	p.SetBackground(48, 32, drawing.ColorWhite)
+	p.OverrideDrawingStyle(Style{
+		FillColor: drawing.ColorBlue,
+	}).Rect(Box{
+		Top:    16,
+		Right:  16,
+		Bottom: 32,
+	})
+	p.OverrideDrawingStyle(Style{
+		StrokeColor: drawing.ColorRed,
+		StrokeWidth: 1,
+	}).LineStroke([]Point{
+		{
+			X: 16,
+			Y: 30,
+		},
+		{
+			X: 47,
+			Y: 0,
+		},
+	})
+	p.OverrideTextStyle(Style{
+		FontColor: drawing.ColorBlack,
+	}).Text("中a", 0, 16)
+	assert.Equal(Box{
+		Right:  24,
+		Bottom: 16,
+	}, p.MeasureText("中a"))
+
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("\x1b[38;2;0;0;0m中a\x1b[38;2;255;0;0m⢀⠔⠉\x1b[0m\n\x1b[38;2;0;0;255m██\x1b[38;2;255;0;0m⡔⠁  \x1b[0m\n", string(buf))
+
+	p.setTerminalOption(TerminalOption{
+		Color:      TerminalColor256,
+		Background: true,
+	})
+	buf, err = p.Bytes()
+	assert.Nil(err)
+	assert.Equal("\x1b[48;5;231m\x1b[38;5;16m中a\x1b[38;5;196m⢀⠔⠉\x1b[0m\n\x1b[48;5;231m\x1b[38;5;21m██\x1b[38;5;196m⡔⠁  \x1b[0m\n", string(buf))
+
+	p.setTerminalOption(TerminalOption{
+		Color: TerminalColorNone,
+	})
+	buf, err = p.Bytes()
+	assert.Nil(err)
+	assert.Equal("中a⢀⠔⠉\n██⡔⠁  \n", string(buf))
+
+	_, err = p.Image()
+	assert.Equal(ErrImageNotSupported, err)
+}
+
+func TestGetANSI256Color(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(uint8(16), getANSI256Color(drawing.ColorBlack))
+	assert.Equal(uint8(231), getANSI256Color(drawing.ColorWhite))
+	assert.Equal(uint8(196), getANSI256Color(drawing.ColorRed))
+	// 灰度
+	assert.Equal(uint8(244), getANSI256Color(drawing.Color{
+		R: 128,
+		G: 128,
+		B: 128,
+		A: 255,
+	}))
+}
+
+func TestTerminalChart(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := LineRender([][]float64{
+		{120, 132, 101, 134, 90, 230, 210},
+	},
+		TerminalTypeOption(),
+		XAxisDataOptionFunc([]string{
+			"Mon",
+			"Tue",
+			"Wed",
+			"Thu",
+			"Fri",
+			"Sat",
+			"Sun",
+		}),
+		LegendLabelsOptionFunc([]string{
+			"Email",
+		}),
+		func(opt *ChartOption) {
+			opt.Terminal = TerminalOption{
+				Columns: 60,
+				Rows:    15,
+				Color:   TerminalColorNone,
+			}
+		},
+	)
+	assert.Nil(err)
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	lines := strings.Split(strings.TrimSuffix(string(buf), "\n"), "\n")
+	assert.Equal(15, len(lines))
+	for _, line := range lines {
+		assert.Equal(60, len([]rune(line)))
+	}
+	data := string(buf)
+	assert.Contains(data, "Email")
+	assert.Contains(data, "Mon")
+	assert.Contains(data, "Sun")
+}
+
+func TestTerminalChartSmallRows(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(color string) string {
+		p, err := LineRender([][]float64{
+			{120, 132, 101, 134, 90, 230, 210},
+		},
+			TerminalTypeOption(),
+			XAxisDataOptionFunc([]string{
+				"Mon",
+				"Tue",
+				"Wed",
+				"Thu",
+				"Fri",
+				"Sat",
+				"Sun",
+			}),
+			func(opt *ChartOption) {
+				opt.Terminal = TerminalOption{
+					Columns: 60,
+					Rows:    10,
+					Color:   color,
+				}
+			},
+		)
+		assert.Nil(err)
+		buf, err := p.Bytes()
+		assert.Nil(err)
+		return string(buf)
+	}
+	// 每个刻度占一行
+	labels := make([]string, 0)
+	for _, line := range strings.Split(render(TerminalColorNone), "\n") {
+		line = strings.TrimSpace(line)
+		index := strings.IndexFunc(line, func(r rune) bool {
+			return r < '0' || r > '9'
+		})
+		if index > 0 {
+			labels = append(labels, line[:index])
+		}
+	}
+	assert.Equal([]string{
+		"280",
+		"240",
+		"200",
+		"160",
+		"120",
+		"80",
+	}, labels)
+
+	// 不展示白色的点
+	assert.NotContains(render(TerminalColorTrue), "38;2;255;255;255m")
+}
+
+func TestTerminalChartLegend(t *testing.T) {
+	assert := assert.New(t)
+
+	values := [][]float64{
+		{120, 132, 101, 134, 90, 230, 210},
+		{220, 182, 191, 234, 290, 330, 310},
+	}
+	opts := []OptionFunc{
+		TerminalTypeOption(),
+		XAxisDataOptionFunc([]string{
+			"Mon",
+			"Tue",
+			"Wed",
+			"Thu",
+			"Fri",
+			"Sat",
+			"Sun",
+		}),
+		LegendLabelsOptionFunc([]string{
+			"Email",
+			"Ads",
+		}),
+		func(opt *ChartOption) {
+			opt.Terminal = TerminalOption{
+				Columns: 60,
+				Rows:    14,
+				Color:   TerminalColorNone,
+			}
+		},
+	}
+	p, err := LineRender(values, opts...)
+	assert.Nil(err)
+	buf, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal(strings.Join([]string{
+		"                                                            ",
+		"                    ⢀⣀⣠⡀⣀Email  ⣀⢀⣄⣀Ads                     ",
+		"                      ⠉⠁         ⠈⠉                         ",
+		"                                                            ",
+		"   420⠈⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉  ",
+		"   360⠈⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⠉⢉⣉⣉⣉⣉⣉⣉⠉⠉⠉⠉⠉⠉⠉  ",
+		"   300⠐⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⢒⣒⡲⠶⠖⠒⠒⠛⠛⠓⠒⠒⠒⠒⠒⠒⠛⠛⠛⠒⠒⠒⠒  ",
+		"   240⠐⠒⠒⠒⣒⡒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⣒⣒⡲⠶⠖⠒⠚⠛⠒⠒⠒⠒⠒⠒⠒⠒⠒⠒⡲⠶⣒⣒⣒⣒⡒⠒⠒⠒⠒⠒  ",
+		"   180⠠⠤⠤⠤⠤⠬⠭⠭⠵⠶⠶⠤⠤⠤⠴⠶⠶⠶⠶⠶⠭⠭⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⡤⠴⠭⠤⠤⠤⠤⠤⠤⠬⠭⠤⠤⠤⠤  ",
+		"   120⠠⠤⠤⠤⡤⠤⠤⠤⠤⠤⠤⠤⠤⠤⢤⣤⣤⠤⠤⠤⢤⣤⣤⡤⠤⠤⠤⠤⢤⣤⡤⠤⠤⠤⣤⠴⠮⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤⠤  ",
+		"    60⢀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣉⣉⣉⣁⣀⣀⣀⣀⣀⣀⣀⣀⣀⣈⣉⣑⣊⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀⣀  ",
+		"      ⠈       ⠁      ⠁      ⠈      ⠈       ⠁      ⠁      ⠈  ",
+		"         Mon    Tue    Wed     Thu    Fri    Sat    Sun     ",
+		"                                                            ",
+	}, "\n")+"\n", string(buf))
+
+	// 图例与图表区域间隔一行
+	for _, fn := range []func([][]float64, ...OptionFunc) (*Painter, error){
+		LineRender,
+		BarRender,
+		HorizontalBarRender,
+	} {
+		p, err := fn(values, opts...)
+		assert.Nil(err)
+		buf, err := p.Bytes()
+		assert.Nil(err)
+		lines := strings.Split(string(buf), "\n")
+		assert.Contains(lines[1], "Email")
+		assert.Contains(lines[1], "Ads")
+		assert.Equal("", strings.TrimSpace(lines[3]))
+	}
+}
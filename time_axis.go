@@ -0,0 +1,232 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"time"
+)
+
+const (
+	timeUnitSecond = iota
+	timeUnitMinute
+	timeUnitHour
+	timeUnitDay
+	timeUnitWeek
+	timeUnitMonth
+	timeUnitYear
+)
+
+type timeInterval struct {
+	unit int
+	step int
+}
+
+// 由小至大的时间间隔
+var timeIntervals = []timeInterval{
+	{timeUnitSecond, 1},
+	{timeUnitSecond, 5},
+	{timeUnitSecond, 15},
+	{timeUnitSecond, 30},
+	{timeUnitMinute, 1},
+	{timeUnitMinute, 5},
+	{timeUnitMinute, 15},
+	{timeUnitMinute, 30},
+	{timeUnitHour, 1},
+	{timeUnitHour, 3},
+	{timeUnitHour, 6},
+	{timeUnitHour, 12},
+	{timeUnitDay, 1},
+	{timeUnitDay, 2},
+	{timeUnitWeek, 1},
+	{timeUnitMonth, 1},
+	{timeUnitMonth, 3},
+	{timeUnitMonth, 6},
+	{timeUnitYear, 1},
+	{timeUnitYear, 2},
+	{timeUnitYear, 5},
+	{timeUnitYear, 10},
+	{timeUnitYear, 50},
+	{timeUnitYear, 100},
+}
+
+// approximate returns the approximate duration of interval
+func (ti timeInterval) approximate() time.Duration {
+	d := time.Second
+	switch ti.unit {
+	case timeUnitMinute:
+		d = time.Minute
+	case timeUnitHour:
+		d = time.Hour
+	case timeUnitDay:
+		d = 24 * time.Hour
+	case timeUnitWeek:
+		d = 7 * 24 * time.Hour
+	case timeUnitMonth:
+		d = 30 * 24 * time.Hour
+	case timeUnitYear:
+		d = 365 * 24 * time.Hour
+	}
+	return d * time.Duration(ti.step)
+}
+
+// floor returns the start of interval which contains t
+func (ti timeInterval) floor(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	loc := t.Location()
+	switch ti.unit {
+	case timeUnitMinute:
+		return time.Date(year, month, day, hour, minute-minute%ti.step, 0, 0, loc)
+	case timeUnitHour:
+		return time.Date(year, month, day, hour-hour%ti.step, 0, 0, 0, loc)
+	case timeUnitDay:
+		return time.Date(year, month, day-(day-1)%ti.step, 0, 0, 0, 0, loc)
+	case timeUnitWeek:
+		// 以周一作为一周的开始
+		return time.Date(year, month, day-(int(t.Weekday())+6)%7, 0, 0, 0, 0, loc)
+	case timeUnitMonth:
+		return time.Date(year, month-(month-1)%time.Month(ti.step), 1, 0, 0, 0, 0, loc)
+	case timeUnitYear:
+		return time.Date(year-year%ti.step, time.January, 1, 0, 0, 0, 0, loc)
+	}
+	return time.Date(year, month, day, hour, minute, second-second%ti.step, 0, loc)
+}
+
+// next returns the start of next interval
+func (ti timeInterval) next(t time.Time) time.Time {
+	switch ti.unit {
+	case timeUnitDay:
+		return t.AddDate(0, 0, ti.step)
+	case timeUnitWeek:
+		return t.AddDate(0, 0, 7*ti.step)
+	case timeUnitMonth:
+		return t.AddDate(0, ti.step, 0)
+	case timeUnitYear:
+		return t.AddDate(ti.step, 0, 0)
+	}
+	return t.Add(ti.approximate())
+}
+
+// format returns the label of tick,
+// the first tick of a larger unit is formatted with the larger unit
+func (ti timeInterval) format(t time.Time) string {
+	hour, minute, second := t.Clock()
+	isDayStart := hour == 0 && minute == 0 && second == 0
+	isYearStart := isDayStart && t.Month() == time.January && t.Day() == 1
+	switch ti.unit {
+	case timeUnitSecond:
+		if isDayStart {
+			return t.Format("01-02")
+		}
+		return t.Format("15:04:05")
+	case timeUnitMinute, timeUnitHour:
+		if isDayStart {
+			return t.Format("01-02")
+		}
+		return t.Format("15:04")
+	case timeUnitDay, timeUnitWeek:
+		return t.Format("01-02")
+	case timeUnitMonth:
+		if isYearStart {
+			return t.Format("2006")
+		}
+		return t.Format("2006-01")
+	}
+	return t.Format("2006")
+}
+
+// ticks returns the ticks of interval which cover start and end
+func (ti timeInterval) ticks(start, end time.Time) []time.Time {
+	t := ti.floor(start)
+	values := []time.Time{
+		t,
+	}
+	for t.Before(end) {
+		t = ti.next(t)
+		values = append(values, t)
+	}
+	return values
+}
+
+type timeAxisTicks struct {
+	// The ticks of axis
+	Values []time.Time
+	// The labels of ticks
+	Labels []string
+}
+
+// Start returns the start time of axis
+func (ta *timeAxisTicks) Start() time.Time {
+	return ta.Values[0]
+}
+
+// End returns the end time of axis
+func (ta *timeAxisTicks) End() time.Time {
+	return ta.Values[len(ta.Values)-1]
+}
+
+// GetX returns the x position of t
+func (ta *timeAxisTicks) GetX(t time.Time, width int) int {
+	span := ta.End().Sub(ta.Start())
+	if span <= 0 {
+		return 0
+	}
+	return int(float64(width) * float64(t.Sub(ta.Start())) / float64(span))
+}
+
+// newTimeAxisTicks returns the calendar aware ticks of time range,
+// the smallest interval whose labels don't overlap is chosen.
+// The layout is used to format the label if it is not empty
+func newTimeAxisTicks(p *Painter, start, end time.Time, width int, layout string) *timeAxisTicks {
+	if !end.After(start) {
+		end = start.Add(time.Second)
+	}
+	span := end.Sub(start)
+	labelMargin := 10
+	var result *timeAxisTicks
+	for index, ti := range timeIntervals {
+		// 避免生成过多的刻度
+		if index != len(timeIntervals)-1 &&
+			int64(span/ti.approximate()) > int64(width) {
+			continue
+		}
+		values := ti.ticks(start, end)
+		labels := make([]string, len(values))
+		for i, value := range values {
+			if layout != "" {
+				labels[i] = value.Format(layout)
+			} else {
+				labels[i] = ti.format(value)
+			}
+		}
+		result = &timeAxisTicks{
+			Values: values,
+			Labels: labels,
+		}
+		textWidth, _ := p.MeasureTextMaxWidthHeight(labels)
+		if width/(len(values)-1) >= textWidth+labelMargin {
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,131 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	value := time.Date(2022, 8, 17, 13, 47, 23, 0, time.UTC)
+	tests := []struct {
+		interval timeInterval
+		floor    time.Time
+		next     time.Time
+	}{
+		{
+			interval: timeInterval{timeUnitSecond, 15},
+			floor:    time.Date(2022, 8, 17, 13, 47, 15, 0, time.UTC),
+			next:     time.Date(2022, 8, 17, 13, 47, 30, 0, time.UTC),
+		},
+		{
+			interval: timeInterval{timeUnitMinute, 5},
+			floor:    time.Date(2022, 8, 17, 13, 45, 0, 0, time.UTC),
+			next:     time.Date(2022, 8, 17, 13, 50, 0, 0, time.UTC),
+		},
+		{
+			interval: timeInterval{timeUnitHour, 6},
+			floor:    time.Date(2022, 8, 17, 12, 0, 0, 0, time.UTC),
+			next:     time.Date(2022, 8, 17, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			interval: timeInterval{timeUnitDay, 1},
+			floor:    time.Date(2022, 8, 17, 0, 0, 0, 0, time.UTC),
+			next:     time.Date(2022, 8, 18, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			interval: timeInterval{timeUnitWeek, 1},
+			floor:    time.Date(2022, 8, 15, 0, 0, 0, 0, time.UTC),
+			next:     time.Date(2022, 8, 22, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			interval: timeInterval{timeUnitMonth, 3},
+			floor:    time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC),
+			next:     time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			interval: timeInterval{timeUnitYear, 5},
+			floor:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			next:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		floor := tt.interval.floor(value)
+		assert.Equal(tt.floor, floor)
+		assert.Equal(tt.next, tt.interval.next(floor))
+	}
+
+	hour := timeInterval{timeUnitHour, 1}
+	assert.Equal("13:00", hour.format(time.Date(2022, 8, 17, 13, 0, 0, 0, time.UTC)))
+	assert.Equal("08-18", hour.format(time.Date(2022, 8, 18, 0, 0, 0, 0, time.UTC)))
+	month := timeInterval{timeUnitMonth, 1}
+	assert.Equal("2022-08", month.format(time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal("2023", month.format(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestNewTimeAxisTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  600,
+		Height: 400,
+	}, PainterThemeOption(defaultTheme))
+	assert.Nil(err)
+	p.SetTextStyle(Style{
+		FontSize: 12,
+		Font:     defaultTheme.GetFont(),
+	})
+
+	start := time.Date(2022, 8, 17, 13, 47, 0, 0, time.UTC)
+	ticks := newTimeAxisTicks(p, start, start.Add(100*time.Minute), 500, "")
+	assert.Equal([]string{
+		"13:45",
+		"14:00",
+		"14:15",
+		"14:30",
+		"14:45",
+		"15:00",
+		"15:15",
+		"15:30",
+	}, ticks.Labels)
+	assert.Equal(0, ticks.GetX(ticks.Start(), 500))
+	assert.Equal(500, ticks.GetX(ticks.End(), 500))
+	assert.Equal(214, ticks.GetX(time.Date(2022, 8, 17, 14, 30, 0, 0, time.UTC), 500))
+
+	ticks = newTimeAxisTicks(p, start, start.AddDate(0, 5, 0), 500, "Jan")
+	assert.Equal([]string{
+		"Aug",
+		"Sep",
+		"Oct",
+		"Nov",
+		"Dec",
+		"Jan",
+		"Feb",
+	}, ticks.Labels)
+}
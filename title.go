@@ -91,6 +91,8 @@ func NewTitlePainter(p *Painter, opt TitleOption) *titlePainter {
 func (t *titlePainter) Render() (Box, error) {
 	opt := t.opt
 	p := t.p
+	p.startComponent("title")
+	defer p.endComponent()
 	theme := opt.Theme
 
 	if theme == nil {
@@ -23,6 +23,9 @@
 package charts
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"math"
 	"regexp"
 	"strconv"
@@ -106,6 +109,21 @@ func autoDivideSpans(max, size int, spans []int) []int {
 	return values
 }
 
+// autoDivideWeights divides the max value by weights
+func autoDivideWeights(max int, weights []int) []int {
+	values := make([]int, len(weights)+1)
+	sum := sumInt(weights)
+	if sum <= 0 {
+		return autoDivide(max, len(weights))
+	}
+	current := 0
+	for index, v := range weights {
+		current += v
+		values[index+1] = int(math.Round(float64(max) * float64(current) / float64(sum)))
+	}
+	return values
+}
+
 func sumInt(values []int) int {
 	sum := 0
 	for _, v := range values {
@@ -219,6 +237,37 @@ func parseColor(color string) Color {
 	return c
 }
 
+// formatColor returns the string of color which can be parsed by parseColor,
+// it returns empty string if the color is zero
+func formatColor(c Color) string {
+	if c.IsZero() {
+		return ""
+	}
+	if c.A == 255 {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("rgba(%d,%d,%d,%d)", c.R, c.G, c.B, c.A)
+}
+
+// unmarshalColor unmarshals the color from json string or rgba object
+func unmarshalColor(data []byte) (Color, error) {
+	data = bytes.TrimSpace(data)
+	c := Color{}
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return c, nil
+	}
+	if data[0] == '{' {
+		err := json.Unmarshal(data, &c)
+		return c, err
+	}
+	value := ""
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return c, err
+	}
+	return parseColor(value), nil
+}
+
 const defaultRadiusPercent = 0.4
 
 func getRadius(diameter float64, radiusValue string) float64 {
@@ -238,12 +287,7 @@ func getRadius(diameter float64, radiusValue string) float64 {
 }
 
 func getPolygonPointAngles(sides int) []float64 {
-	angles := make([]float64, sides)
-	for i := 0; i < sides; i++ {
-		angle := 2*math.Pi/float64(sides)*float64(i) - (math.Pi / 2)
-		angles[i] = angle
-	}
-	return angles
+	return getPolarAngles(sides, -math.Pi/2)
 }
 
 func getPolygonPoint(center Point, radius, angle float64) Point {
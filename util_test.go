@@ -70,6 +70,29 @@ func TestAutoDivide(t *testing.T) {
 	}, autoDivide(600, 7))
 }
 
+func TestAutoDivideWeights(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]int{
+		0,
+		100,
+		400,
+		600,
+	}, autoDivideWeights(600, []int{
+		50,
+		150,
+		100,
+	}))
+	assert.Equal([]int{
+		0,
+		300,
+		600,
+	}, autoDivideWeights(600, []int{
+		0,
+		0,
+	}))
+}
+
 func TestGetRadius(t *testing.T) {
 	assert := assert.New(t)
 
@@ -0,0 +1,312 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type VisualMapPiece struct {
+	// The minimum value of piece(inclusive), nil means no limit
+	Min *float64
+	// The maximum value of piece(exclusive), nil means no limit
+	Max *float64
+	// The color of piece
+	Color Color
+	// The label of piece, it will be generated by min and max if it is empty
+	Label string
+}
+
+type VisualMapOption struct {
+	// The minimum value of continuous visual map, the min value of data will be used if it is nil
+	Min *float64
+	// The maximum value of continuous visual map, the max value of data will be used if it is nil
+	Max *float64
+	// The colors of continuous visual map, from min to max
+	Colors []Color
+	// The pieces of piecewise visual map, the visual map is continuous if it is nil
+	Pieces []VisualMapPiece
+	// The flag for show visual map, set this to *false will hide it
+	Show *bool
+	// The font size of visual map
+	FontSize float64
+	// The font color of visual map
+	FontColor Color
+}
+
+type visualMap struct {
+	min    float64
+	max    float64
+	colors []Color
+	pieces []VisualMapPiece
+}
+
+// interpolateColor returns the color of percent from color stops
+func interpolateColor(colors []Color, percent float64) Color {
+	if len(colors) == 0 {
+		return Color{}
+	}
+	if len(colors) == 1 || percent <= 0 {
+		return colors[0]
+	}
+	if percent >= 1 {
+		return colors[len(colors)-1]
+	}
+	position := percent * float64(len(colors)-1)
+	index := int(position)
+	offset := position - float64(index)
+	c0 := colors[index]
+	c1 := colors[index+1]
+	mix := func(v0, v1 uint8) uint8 {
+		return uint8(math.Round(float64(v0) + (float64(v1)-float64(v0))*offset))
+	}
+	return Color{
+		R: mix(c0.R, c1.R),
+		G: mix(c0.G, c1.G),
+		B: mix(c0.B, c1.B),
+		A: mix(c0.A, c1.A),
+	}
+}
+
+func newVisualMap(opt VisualMapOption, theme ColorPalette, min, max float64) *visualMap {
+	if opt.Min != nil {
+		min = *opt.Min
+	}
+	if opt.Max != nil {
+		max = *opt.Max
+	}
+	colors := opt.Colors
+	if len(colors) == 0 {
+		color := theme.GetSeriesColor(0)
+		colors = []Color{
+			lightenColor(color, 0.8),
+			color,
+		}
+	}
+	return &visualMap{
+		min:    min,
+		max:    max,
+		colors: colors,
+		pieces: opt.Pieces,
+	}
+}
+
+// IsPiecewise returns true if the visual map is piecewise
+func (vm *visualMap) IsPiecewise() bool {
+	return len(vm.pieces) != 0
+}
+
+// GetColor returns the color of value, the second value is false
+// if the value doesn't match any piece
+func (vm *visualMap) GetColor(value float64) (Color, bool) {
+	if vm.IsPiecewise() {
+		for _, piece := range vm.pieces {
+			if piece.Min != nil && value < *piece.Min {
+				continue
+			}
+			if piece.Max != nil && value >= *piece.Max {
+				continue
+			}
+			return piece.Color, true
+		}
+		return Color{}, false
+	}
+	percent := float64(1)
+	if vm.max > vm.min {
+		percent = (value - vm.min) / (vm.max - vm.min)
+	}
+	return interpolateColor(vm.colors, percent), true
+}
+
+// getPieceLabel returns the label of piece
+func getPieceLabel(piece VisualMapPiece, formatter ValueFormatter) string {
+	if piece.Label != "" {
+		return piece.Label
+	}
+	switch {
+	case piece.Min != nil && piece.Max != nil:
+		return formatter(*piece.Min) + " - " + formatter(*piece.Max)
+	case piece.Min != nil:
+		return "≥ " + formatter(*piece.Min)
+	case piece.Max != nil:
+		return "< " + formatter(*piece.Max)
+	}
+	return ""
+}
+
+type visualMapPainter struct {
+	p   *Painter
+	vm  *visualMap
+	opt *visualMapRenderOption
+}
+
+type visualMapRenderOption struct {
+	Theme     ColorPalette
+	Font      *truetype.Font
+	FontSize  float64
+	FontColor Color
+	// The size of each color block
+	ItemSize int
+}
+
+func newVisualMapPainter(p *Painter, vm *visualMap, opt visualMapRenderOption) *visualMapPainter {
+	return &visualMapPainter{
+		p:   p,
+		vm:  vm,
+		opt: &opt,
+	}
+}
+
+// Render renders the visual map at the right bottom of painter
+func (v *visualMapPainter) Render() (Box, error) {
+	p := v.p
+	p.startComponent("visual-map")
+	defer p.endComponent()
+	opt := v.opt
+	vm := v.vm
+	theme := opt.Theme
+	if theme == nil {
+		theme = p.theme
+	}
+	fontSize := opt.FontSize
+	if fontSize == 0 {
+		fontSize = labelFontSize
+	}
+	fontColor := opt.FontColor
+	if fontColor.IsZero() {
+		fontColor = theme.GetTextColor()
+	}
+	itemSize := opt.ItemSize
+	if itemSize <= 0 {
+		itemSize = 10
+	}
+	formatter := commafWithDigits
+	if p.valueFormatter != nil {
+		formatter = p.valueFormatter
+	}
+	p.OverrideTextStyle(Style{
+		FontSize:  fontSize,
+		FontColor: fontColor,
+		Font:      opt.Font,
+	})
+	gap := 3
+	textMargin := 5
+
+	type item struct {
+		text  string
+		color Color
+	}
+	items := make([]item, 0)
+	// 连续型：最小值 + 色块 + 最大值
+	// 分段型：色块 + 文本
+	var startText, endText string
+	if vm.IsPiecewise() {
+		for _, piece := range vm.pieces {
+			items = append(items, item{
+				text:  getPieceLabel(piece, formatter),
+				color: piece.Color,
+			})
+		}
+	} else {
+		startText = formatter(vm.min)
+		endText = formatter(vm.max)
+		count := 5
+		for i := 0; i < count; i++ {
+			items = append(items, item{
+				color: interpolateColor(vm.colors, float64(i)/float64(count-1)),
+			})
+		}
+	}
+
+	height := itemSize
+	for _, text := range []string{
+		startText,
+		endText,
+	} {
+		if text != "" {
+			height = chart.MaxInt(height, p.MeasureText(text).Height())
+		}
+	}
+	for _, item := range items {
+		if item.text != "" {
+			height = chart.MaxInt(height, p.MeasureText(item.text).Height())
+		}
+	}
+	top := p.Height() - height
+	textY := top + (height+int(fontSize))>>1
+	itemTop := top + (height-itemSize)>>1
+
+	// 第一次计算宽度，第二次绘制
+	layout := func(x int, draw bool) int {
+		drawText := func(text string) {
+			if draw {
+				p.Text(text, x, textY)
+			}
+			x += p.MeasureText(text).Width()
+		}
+		if startText != "" {
+			drawText(startText)
+			x += textMargin
+		}
+		for index, item := range items {
+			if index != 0 {
+				x += gap
+			}
+			if draw {
+				p.OverrideDrawingStyle(Style{
+					FillColor: item.color,
+				}).Rect(Box{
+					Left:   x,
+					Top:    itemTop,
+					Right:  x + itemSize,
+					Bottom: itemTop + itemSize,
+				})
+			}
+			x += itemSize
+			if item.text != "" {
+				x += textMargin
+				drawText(item.text)
+				x += textMargin
+			}
+		}
+		if endText != "" {
+			x += textMargin
+			drawText(endText)
+		}
+		return x
+	}
+	width := layout(0, false)
+	left := p.Width() - width
+	if left < 0 {
+		left = 0
+	}
+	layout(left, true)
+
+	return Box{
+		Right:  width,
+		Bottom: height,
+	}, nil
+}
@@ -0,0 +1,106 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterpolateColor(t *testing.T) {
+	assert := assert.New(t)
+
+	colors := []Color{
+		{R: 0, G: 0, B: 0, A: 255},
+		{R: 200, G: 100, B: 50, A: 255},
+	}
+	assert.Equal(Color{}, interpolateColor(nil, 0.5))
+	assert.Equal(colors[0], interpolateColor(colors, -1))
+	assert.Equal(colors[1], interpolateColor(colors, 2))
+	assert.Equal(Color{R: 100, G: 50, B: 25, A: 255}, interpolateColor(colors, 0.5))
+}
+
+func TestVisualMapGetColor(t *testing.T) {
+	assert := assert.New(t)
+
+	colors := []Color{
+		{R: 0, G: 0, B: 0, A: 255},
+		{R: 200, G: 100, B: 50, A: 255},
+	}
+	vm := newVisualMap(VisualMapOption{
+		Colors: colors,
+	}, defaultTheme, 0, 10)
+	assert.False(vm.IsPiecewise())
+	c, ok := vm.GetColor(5)
+	assert.True(ok)
+	assert.Equal(Color{R: 100, G: 50, B: 25, A: 255}, c)
+	c, _ = vm.GetColor(20)
+	assert.Equal(colors[1], c)
+
+	vm = newVisualMap(VisualMapOption{
+		Pieces: []VisualMapPiece{
+			{
+				Max:   NewFloatPoint(5),
+				Color: colors[0],
+			},
+			{
+				Min:   NewFloatPoint(5),
+				Max:   NewFloatPoint(10),
+				Color: colors[1],
+			},
+		},
+	}, defaultTheme, 0, 10)
+	assert.True(vm.IsPiecewise())
+	c, ok = vm.GetColor(1)
+	assert.True(ok)
+	assert.Equal(colors[0], c)
+	c, ok = vm.GetColor(5)
+	assert.True(ok)
+	assert.Equal(colors[1], c)
+	_, ok = vm.GetColor(10)
+	assert.False(ok)
+}
+
+func TestGetPieceLabel(t *testing.T) {
+	assert := assert.New(t)
+
+	formatter := func(f float64) string {
+		return commafWithDigits(f)
+	}
+	assert.Equal("Low", getPieceLabel(VisualMapPiece{
+		Min:   NewFloatPoint(1),
+		Label: "Low",
+	}, formatter))
+	assert.Equal("1 - 10", getPieceLabel(VisualMapPiece{
+		Min: NewFloatPoint(1),
+		Max: NewFloatPoint(10),
+	}, formatter))
+	assert.Equal("≥ 1", getPieceLabel(VisualMapPiece{
+		Min: NewFloatPoint(1),
+	}, formatter))
+	assert.Equal("< 10", getPieceLabel(VisualMapPiece{
+		Max: NewFloatPoint(10),
+	}, formatter))
+	assert.Equal("", getPieceLabel(VisualMapPiece{}, formatter))
+}